@@ -0,0 +1,64 @@
+package glide
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+)
+
+//go:generate counterfeiter -o glidefakes/fake_api.go . API
+
+// API is the read only part of the Concourse API implemented by Client.
+// Depend on API instead of *Client to substitute a fake in tests.
+type API interface {
+	Info(ctx context.Context) (Info, error)
+	UserInfo(ctx context.Context) (UserInfo, error)
+	Wall(ctx context.Context) (Wall, error)
+	CredsInfo(ctx context.Context) (CredsInfo, error)
+
+	Teams(ctx context.Context) ([]Team, error)
+
+	AllPipelines(ctx context.Context) ([]Pipeline, error)
+	AllPipelinesByTeam(ctx context.Context, concurrency int) (map[string][]Pipeline, error)
+	Pipeline(ctx context.Context, team, pipeline string) (Pipeline, error)
+	Pipelines(ctx context.Context, team string) ([]Pipeline, error)
+	PipelineConfig(ctx context.Context, team, pipeline string) (config []byte, version string, err error)
+
+	AllResources(ctx context.Context) ([]Resource, error)
+	Resources(ctx context.Context, team, pipeline string) ([]Resource, error)
+	Resource(ctx context.Context, team, pipeline, resource string) (Resource, error)
+	ResourceVersions(ctx context.Context, team, pipeline, resource string) ([]ResourceVersion, error)
+	ResourceVersionsPage(ctx context.Context, team, pipeline, resource string, page Page) ([]ResourceVersion, Pagination, error)
+	ResourceTypes(ctx context.Context, team, pipeline string) ([]ResourceType, error)
+	ResourceVersionCausality(ctx context.Context, team, pipeline, resource string, versionID int) (Causality, error)
+
+	AllJobs(ctx context.Context) ([]Job, error)
+	Jobs(ctx context.Context, team, pipeline string) ([]Job, error)
+	TeamJobs(ctx context.Context, team string) ([]Job, error)
+	JobStatuses(ctx context.Context, team, pipeline string) (map[string]Build, error)
+	Job(ctx context.Context, team, pipeline, job string) (Job, error)
+	JobInputs(ctx context.Context, team, pipeline, job string) ([]JobInput, error)
+	JobBuilds(ctx context.Context, team, pipeline, job string) ([]Build, error)
+	JobBuildsWithResourceVersion(ctx context.Context, team, pipeline, resource string, versionID int) ([]Build, error)
+	BuildsWithResourceVersionAsOutput(ctx context.Context, team, pipeline, resource string, versionID int) ([]Build, error)
+
+	Builds(ctx context.Context, page Page) ([]Build, Pagination, error)
+	TeamBuilds(ctx context.Context, team string, page Page) ([]Build, Pagination, error)
+	PipelineBuilds(ctx context.Context, team, pipeline string, page Page) ([]Build, Pagination, error)
+	JobBuildsPage(ctx context.Context, team, pipeline, job string, page Page) ([]Build, Pagination, error)
+
+	Build(ctx context.Context, buildID int) (Build, error)
+	BuildResources(ctx context.Context, buildID int) (BuildResources, error)
+	BuildPreparation(ctx context.Context, buildID int) (BuildPreparation, error)
+	BuildPlan(ctx context.Context, buildID int) (json.RawMessage, string, error)
+	BuildEvents(ctx context.Context, buildID int) (<-chan BuildEvent, error)
+	AllBuildEvents(ctx context.Context, buildID int) ([]BuildEvent, error)
+	BuildLog(ctx context.Context, buildID int) (io.ReadCloser, error)
+
+	Workers(ctx context.Context) ([]Worker, error)
+	Containers(ctx context.Context, team string, filter ContainerFilter) ([]Container, error)
+	Container(ctx context.Context, team, handle string) (Container, error)
+	Volumes(ctx context.Context, team string) ([]Volume, error)
+}
+
+var _ API = (*Client)(nil)
@@ -0,0 +1,316 @@
+package glide
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"slices"
+)
+
+// maxCausalityNodes bounds the number of nodes ResourceVersionCausality
+// adds to a graph. Each node costs at least one request to the ATC.
+const maxCausalityNodes = 500
+
+// Causality is the directed graph of the builds and resource versions a
+// resource version came from and went into. Edges point downstream: from a
+// version to the builds that used it as an input and from a build to the
+// versions it produced.
+type Causality struct {
+	// Root is the version the graph was walked from.
+	Root *CausalityNode
+
+	// Nodes holds every node in the order it was found, starting with Root.
+	Nodes []*CausalityNode
+
+	// Truncated is true when the walk stopped after maxCausalityNodes nodes.
+	Truncated bool
+}
+
+// CausalityNode is a build or a resource version. Exactly one of Build and
+// Version is set.
+type CausalityNode struct {
+	Build   *Build
+	Version *CausalityVersion
+
+	// Upstream holds the nodes this node came from. For a version these are
+	// the builds that output it and for a build the versions it used as
+	// inputs.
+	Upstream []*CausalityNode
+
+	// Downstream holds the nodes that came from this node.
+	Downstream []*CausalityNode
+}
+
+// CausalityVersion is a version of a resource in the pipeline. ID is zero
+// when the version was not found in the resource versions, so it was not
+// walked further.
+type CausalityVersion struct {
+	Resource string
+	ResourceVersion
+}
+
+// ResourceVersionCausality walks the builds and versions upstream and
+// downstream of a resource version. Upstream, it follows the builds that
+// output each version to the versions they used as inputs. Downstream, it
+// follows the builds that used each version as an input to the versions
+// they output. The walk stops after maxCausalityNodes nodes.
+func (client *Client) ResourceVersionCausality(ctx context.Context, team, pipeline, resource string, versionID int) (Causality, error) {
+	walker := &causalityWalker{
+		client:         client,
+		team:           team,
+		pipeline:       pipeline,
+		versionNodes:   make(map[causalityVersionKey]*CausalityNode),
+		buildNodes:     make(map[int]*CausalityNode),
+		buildResources: make(map[int]BuildResources),
+		versions:       make(map[string]*causalityVersions),
+		expanded:       make(map[causalityStep]bool),
+	}
+	root, err := walker.resolveVersion(ctx, resource, versionID)
+	if err != nil {
+		return Causality{}, err
+	}
+	walker.graph.Root = walker.versionNode(root)
+	queue := []causalityStep{
+		{node: walker.graph.Root, upstream: true},
+		{node: walker.graph.Root, upstream: false},
+	}
+	for len(queue) > 0 {
+		step := queue[0]
+		queue = queue[1:]
+		if walker.expanded[step] {
+			continue
+		}
+		walker.expanded[step] = true
+		next, err := walker.expand(ctx, step)
+		if err != nil {
+			return Causality{}, err
+		}
+		queue = append(queue, next...)
+	}
+	return walker.graph, nil
+}
+
+type causalityWalker struct {
+	client         *Client
+	team, pipeline string
+	graph          Causality
+
+	versionNodes   map[causalityVersionKey]*CausalityNode
+	buildNodes     map[int]*CausalityNode
+	buildResources map[int]BuildResources
+	versions       map[string]*causalityVersions
+	expanded       map[causalityStep]bool
+}
+
+// causalityVersionKey identifies a version node. Version is only set for
+// versions without an ID.
+type causalityVersionKey struct {
+	resource string
+	id       int
+	version  string
+}
+
+// causalityVersions holds the pages of resource versions fetched so far.
+// Next is nil once every page has been fetched.
+type causalityVersions struct {
+	versions []ResourceVersion
+	next     *Page
+}
+
+// causalityStep is a node to expand in one direction.
+type causalityStep struct {
+	node     *CausalityNode
+	upstream bool
+}
+
+func (walker *causalityWalker) expand(ctx context.Context, step causalityStep) ([]causalityStep, error) {
+	var next []causalityStep
+	switch {
+	case step.node.Version != nil:
+		version := step.node.Version
+		if version.ID == 0 {
+			return nil, nil
+		}
+		var (
+			builds []Build
+			err    error
+		)
+		if step.upstream {
+			builds, err = walker.client.BuildsWithResourceVersionAsOutput(ctx, walker.team, walker.pipeline, version.Resource, version.ID)
+		} else {
+			builds, err = walker.client.JobBuildsWithResourceVersion(ctx, walker.team, walker.pipeline, version.Resource, version.ID)
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, build := range builds {
+			node := walker.buildNode(build)
+			if node == nil {
+				break
+			}
+			walker.link(step.node, node, step.upstream)
+			next = append(next, causalityStep{node: node, upstream: step.upstream})
+		}
+	case step.node.Build != nil:
+		resources, err := walker.resources(ctx, step.node.Build.ID)
+		if err != nil {
+			return nil, err
+		}
+		type result struct {
+			resource string
+			version  json.RawMessage
+		}
+		var results []result
+		if step.upstream {
+			for _, input := range resources.Inputs {
+				results = append(results, result{resource: input.Resource, version: input.Version})
+			}
+		} else {
+			for _, output := range resources.Outputs {
+				results = append(results, result{resource: output.Resource, version: output.Version})
+			}
+		}
+		for _, r := range results {
+			version, err := walker.findVersion(ctx, r.resource, r.version)
+			if err != nil {
+				return nil, err
+			}
+			node := walker.versionNode(version)
+			if node == nil {
+				break
+			}
+			walker.link(step.node, node, step.upstream)
+			next = append(next, causalityStep{node: node, upstream: step.upstream})
+		}
+	}
+	return next, nil
+}
+
+// link adds an edge between node and the node found by walking from it.
+func (walker *causalityWalker) link(node, found *CausalityNode, upstream bool) {
+	from, to := found, node
+	if !upstream {
+		from, to = node, found
+	}
+	if !slices.Contains(from.Downstream, to) {
+		from.Downstream = append(from.Downstream, to)
+	}
+	if !slices.Contains(to.Upstream, from) {
+		to.Upstream = append(to.Upstream, from)
+	}
+}
+
+// addNode returns nil after the graph reaches maxCausalityNodes nodes.
+func (walker *causalityWalker) addNode(node *CausalityNode) *CausalityNode {
+	if len(walker.graph.Nodes) >= maxCausalityNodes {
+		walker.graph.Truncated = true
+		return nil
+	}
+	walker.graph.Nodes = append(walker.graph.Nodes, node)
+	return node
+}
+
+func (walker *causalityWalker) buildNode(build Build) *CausalityNode {
+	if node, found := walker.buildNodes[build.ID]; found {
+		return node
+	}
+	node := walker.addNode(&CausalityNode{Build: &build})
+	if node != nil {
+		walker.buildNodes[build.ID] = node
+	}
+	return node
+}
+
+func (walker *causalityWalker) versionNode(version CausalityVersion) *CausalityNode {
+	key := causalityVersionKey{resource: version.Resource, id: version.ID}
+	if version.ID == 0 {
+		key.version = string(version.Version)
+	}
+	if node, found := walker.versionNodes[key]; found {
+		return node
+	}
+	node := walker.addNode(&CausalityNode{Version: &version})
+	if node != nil {
+		walker.versionNodes[key] = node
+	}
+	return node
+}
+
+func (walker *causalityWalker) resources(ctx context.Context, buildID int) (BuildResources, error) {
+	if resources, found := walker.buildResources[buildID]; found {
+		return resources, nil
+	}
+	resources, err := walker.client.BuildResources(ctx, buildID)
+	if err != nil {
+		return BuildResources{}, err
+	}
+	walker.buildResources[buildID] = resources
+	return resources, nil
+}
+
+// searchVersions returns the first version of the resource that matches.
+// Pages of versions are only fetched until a match is found.
+func (walker *causalityWalker) searchVersions(ctx context.Context, resource string, match func(ResourceVersion) bool) (ResourceVersion, bool, error) {
+	cache, found := walker.versions[resource]
+	if !found {
+		cache = &causalityVersions{next: new(Page)}
+		walker.versions[resource] = cache
+	}
+	for _, version := range cache.versions {
+		if match(version) {
+			return version, true, nil
+		}
+	}
+	for cache.next != nil {
+		versions, pagination, err := walker.client.ResourceVersionsPage(ctx, walker.team, walker.pipeline, resource, *cache.next)
+		if err != nil {
+			return ResourceVersion{}, false, err
+		}
+		cache.versions = append(cache.versions, versions...)
+		cache.next = pagination.Next
+		for _, version := range versions {
+			if match(version) {
+				return version, true, nil
+			}
+		}
+	}
+	return ResourceVersion{}, false, nil
+}
+
+// resolveVersion looks up a version by ID. A version missing from the
+// resource versions is returned without its Version set.
+func (walker *causalityWalker) resolveVersion(ctx context.Context, resource string, versionID int) (CausalityVersion, error) {
+	version, found, err := walker.searchVersions(ctx, resource, func(version ResourceVersion) bool {
+		return version.ID == versionID
+	})
+	if err != nil {
+		return CausalityVersion{}, err
+	}
+	if !found {
+		version = ResourceVersion{ID: versionID}
+	}
+	return CausalityVersion{Resource: resource, ResourceVersion: version}, nil
+}
+
+// findVersion looks up the ID of a version reported by a build. A version
+// missing from the resource versions is returned with a zero ID.
+func (walker *causalityWalker) findVersion(ctx context.Context, resource string, raw json.RawMessage) (CausalityVersion, error) {
+	version, found, err := walker.searchVersions(ctx, resource, func(version ResourceVersion) bool {
+		return equalJSON(version.Version, raw)
+	})
+	if err != nil {
+		return CausalityVersion{}, err
+	}
+	if !found {
+		version = ResourceVersion{Version: raw}
+	}
+	return CausalityVersion{Resource: resource, ResourceVersion: version}, nil
+}
+
+func equalJSON(a, b json.RawMessage) bool {
+	var x, y any
+	if json.Unmarshal(a, &x) != nil || json.Unmarshal(b, &y) != nil {
+		return false
+	}
+	return reflect.DeepEqual(x, y)
+}
@@ -0,0 +1,148 @@
+package glide_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/crhntr/glide"
+)
+
+func TestClient_ResourceVersionCausality(t *testing.T) {
+	// src#3 -> build 5 -> repo#1 -> build 10 -> image#2 -> build 11
+	responses := map[string]string{
+		"/api/v1/teams/main/pipelines/p/resources/repo/versions":             `[{"id":1,"version":{"ref":"b"}}]`,
+		"/api/v1/teams/main/pipelines/p/resources/repo/versions/1/output_of": `[{"id":5,"name":"1","job_name":"build"}]`,
+		"/api/v1/teams/main/pipelines/p/resources/repo/versions/1/input_to":  `[{"id":10,"name":"1","job_name":"unit"}]`,
+		"/api/v1/builds/5/resources":                                         `{"inputs":[{"name":"src","resource":"src","version":{"ref":"a"}}],"outputs":[{"name":"repo","resource":"repo","version":{"ref":"b"}}]}`,
+		"/api/v1/teams/main/pipelines/p/resources/src/versions":              `[{"id":3,"version":{"ref":"a"}}]`,
+		"/api/v1/teams/main/pipelines/p/resources/src/versions/3/output_of":  `[]`,
+		"/api/v1/builds/10/resources":                                        `{"inputs":[{"name":"repo","resource":"repo","version":{"ref":"b"}}],"outputs":[{"name":"image","resource":"image","version":{"digest":"sha256:x"}}]}`,
+		"/api/v1/teams/main/pipelines/p/resources/image/versions":            `[{"id":2,"version":{"digest":"sha256:x"}}]`,
+		"/api/v1/teams/main/pipelines/p/resources/image/versions/2/input_to": `[{"id":11,"name":"1","job_name":"deploy"}]`,
+		"/api/v1/builds/11/resources":                                        `{"inputs":[{"name":"image","resource":"image","version":{"digest":"sha256:x"}}],"outputs":[]}`,
+	}
+	mux := http.NewServeMux()
+	for p, body := range responses {
+		mux.HandleFunc(p, func(res http.ResponseWriter, req *http.Request) {
+			_, _ = io.WriteString(res, body)
+		})
+	}
+	mux.HandleFunc("/sky/issuer/token", writeToken)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.Client{URL: server.URL}
+
+	causality, err := concourse.ResourceVersionCausality(context.Background(), "main", "p", "repo", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if causality.Truncated {
+		t.Error("unexpected truncated graph")
+	}
+	if len(causality.Nodes) != 6 {
+		t.Errorf("expected 6 nodes got %d", len(causality.Nodes))
+	}
+
+	root := causality.Root
+	if root.Version == nil || root.Version.Resource != "repo" || root.Version.ID != 1 {
+		t.Fatalf("unexpected root: %#v", root)
+	}
+
+	// walk upstream to the source version
+	if len(root.Upstream) != 1 || root.Upstream[0].Build == nil || root.Upstream[0].Build.ID != 5 {
+		t.Fatalf("unexpected upstream of root: %#v", root.Upstream)
+	}
+	build := root.Upstream[0]
+	if len(build.Upstream) != 1 || build.Upstream[0].Version == nil || build.Upstream[0].Version.Resource != "src" || build.Upstream[0].Version.ID != 3 {
+		t.Fatalf("unexpected upstream of build 5: %#v", build.Upstream)
+	}
+	if len(build.Upstream[0].Upstream) != 0 {
+		t.Errorf("expected src to have no upstream: %#v", build.Upstream[0].Upstream)
+	}
+
+	// walk downstream to the deploy build
+	node := root
+	for _, want := range []struct {
+		Resource string
+		ID       int
+	}{
+		{ID: 10},
+		{Resource: "image", ID: 2},
+		{ID: 11},
+	} {
+		if len(node.Downstream) != 1 {
+			t.Fatalf("expected one downstream node before %v got %#v", want, node.Downstream)
+		}
+		parent := node
+		node = node.Downstream[0]
+		switch {
+		case want.Resource == "" && (node.Build == nil || node.Build.ID != want.ID),
+			want.Resource != "" && (node.Version == nil || node.Version.Resource != want.Resource || node.Version.ID != want.ID):
+			t.Fatalf("expected %v got %#v", want, node)
+		}
+		if len(node.Upstream) != 1 || node.Upstream[0] != parent {
+			t.Errorf("expected the upstream of %v to be its parent", want)
+		}
+	}
+	if node.Build == nil || node.Build.ID != 11 {
+		t.Errorf("unexpected last node: %#v", node)
+	}
+	if len(node.Downstream) != 0 {
+		t.Errorf("expected build 11 to have no downstream: %#v", node.Downstream)
+	}
+}
+
+func TestClient_ResourceVersionCausality_pagedVersions(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/teams/main/pipelines/p/resources/repo/versions", func(res http.ResponseWriter, req *http.Request) {
+		_, _ = io.WriteString(res, `[{"id":1,"version":{"ref":"b"}}]`)
+	})
+	mux.HandleFunc("/api/v1/teams/main/pipelines/p/resources/repo/versions/1/output_of", func(res http.ResponseWriter, req *http.Request) {
+		_, _ = io.WriteString(res, `[{"id":5,"name":"1","job_name":"build"}]`)
+	})
+	mux.HandleFunc("/api/v1/teams/main/pipelines/p/resources/repo/versions/1/input_to", func(res http.ResponseWriter, req *http.Request) {
+		_, _ = io.WriteString(res, `[]`)
+	})
+	mux.HandleFunc("/api/v1/builds/5/resources", func(res http.ResponseWriter, req *http.Request) {
+		_, _ = io.WriteString(res, `{"inputs":[{"name":"src","resource":"src","version":{"ref":"a"}}],"outputs":[]}`)
+	})
+	var versionPages []string
+	mux.HandleFunc("/api/v1/teams/main/pipelines/p/resources/src/versions", func(res http.ResponseWriter, req *http.Request) {
+		until := req.URL.Query().Get("until")
+		versionPages = append(versionPages, until)
+		switch until {
+		case "":
+			res.Header().Set("Link", `</api/v1/teams/main/pipelines/p/resources/src/versions?until=4&limit=1>; rel="next"`)
+			_, _ = io.WriteString(res, `[{"id":4,"version":{"ref":"newer"}}]`)
+		case "4":
+			_, _ = io.WriteString(res, `[{"id":3,"version":{"ref":"a"}}]`)
+		default:
+			t.Errorf("unexpected page until %q", until)
+		}
+	})
+	mux.HandleFunc("/api/v1/teams/main/pipelines/p/resources/src/versions/3/output_of", func(res http.ResponseWriter, req *http.Request) {
+		_, _ = io.WriteString(res, `[]`)
+	})
+	mux.HandleFunc("/sky/issuer/token", writeToken)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.Client{URL: server.URL}
+
+	causality, err := concourse.ResourceVersionCausality(context.Background(), "main", "p", "repo", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	build := causality.Root.Upstream[0]
+	if len(build.Upstream) != 1 {
+		t.Fatalf("unexpected upstream of build: %#v", build.Upstream)
+	}
+	if src := build.Upstream[0].Version; src == nil || src.Resource != "src" || src.ID != 3 {
+		t.Errorf("expected the version from the second page got: %#v", src)
+	}
+	if len(versionPages) != 2 {
+		t.Errorf("expected two version pages got %q", versionPages)
+	}
+}
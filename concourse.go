@@ -3,19 +3,28 @@ package glide
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"net/http"
+	"net/url"
 	"os"
 	"path"
+	"slices"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
-	"github.com/vito/go-sse/sse"
 	"golang.org/x/oauth2"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/singleflight"
+	"golang.org/x/time/rate"
 )
 
 type Client struct {
@@ -25,15 +34,155 @@ type Client struct {
 	Username string
 	Password string
 
-	token atomic.Pointer[oauth2.Token]
+	// BearerToken, when set, is used as the access token instead of
+	// requesting one from the sky issuer with Username and Password. It
+	// defaults to the CONCOURSE_TOKEN environment variable.
+	BearerToken string
+
+	// TLSConfig, when set, is used by the transport for connections to the
+	// ATC, for example to present a client certificate. It can only be
+	// applied when Client.Transport is nil or an *http.Transport; otherwise
+	// requests fail. Use Middleware to wrap the transport instead.
+	TLSConfig *tls.Config
+
+	// InsecureSkipVerify disables verification of the ATC certificate. It has
+	// the same restrictions as TLSConfig.
+	InsecureSkipVerify bool
+
+	// RootCAs, when set, is used to verify the ATC certificate instead of the
+	// system pool. It has the same restrictions as TLSConfig.
+	RootCAs *x509.CertPool
+
+	// Proxy, when set, selects the proxy for requests to the ATC like
+	// http.Transport.Proxy. It has the same restrictions as TLSConfig.
+	Proxy func(*http.Request) (*url.URL, error)
+
+	// ClientID, ClientSecret, and Scopes override the OAuth2 client used by
+	// fly when set.
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+
+	// TokenCache, when set, persists tokens between processes. The
+	// in-memory token is checked before the cache.
+	TokenCache TokenCache
+
+	// OnToken, when set, is called after a new token is fetched from the sky
+	// issuer or refreshed.
+	OnToken func(*oauth2.Token)
+
+	// RetryPolicy configures retries of requests that fail with a transient
+	// status. By default requests are not retried.
+	RetryPolicy RetryPolicy
+
+	// Limiter, when set, is waited on before each request to the ATC.
+	Limiter *rate.Limiter
+
+	// Timeout, when positive, limits requests made with a context that has no
+	// deadline. Streaming build events is not limited by Timeout.
+	Timeout time.Duration
+
+	// BuildEventsMaxReconnects is how many times BuildEvents reopens a build
+	// event stream that fails before the build ends. Reopened streams resume
+	// after the last received event. Attempts are spaced out like retries
+	// using RetryPolicy.BaseDelay. By default streams are not reopened.
+	BuildEventsMaxReconnects int
+
+	// Middleware, when set, wraps the transport after the TLS and proxy
+	// fields are applied. The wrapped transport sees requests as they are
+	// sent to the ATC, including the authorization header, which makes it
+	// the place to add logging or metrics.
+	Middleware func(http.RoundTripper) http.RoundTripper
+
+	token         atomic.Pointer[oauth2.Token]
+	tokenRequests singleflight.Group
+
+	lastRequestID atomic.Pointer[string]
+
+	unauthenticated http.Client
+	setupErr        error
 
 	runSetupClient, runLoadEnvironment sync.Once
 }
 
 func (client *Client) Do(req *http.Request) (*http.Response, error) {
+	return client.do(req, client.Timeout, &client.Client)
+}
+
+// do sends req with httpClient. When timeout is positive and the request
+// context does not have a deadline, the request, including reading the
+// response body, must finish within timeout.
+func (client *Client) do(req *http.Request, timeout time.Duration, httpClient *http.Client) (*http.Response, error) {
 	client.runLoadEnvironment.Do(client.loadEnvironment)
 	client.runSetupClient.Do(client.setupClient)
-	return client.Client.Do(req)
+	if client.setupErr != nil {
+		if req.Body != nil {
+			closeAndIgnoreErr(req.Body)
+		}
+		return nil, client.setupErr
+	}
+	send := func(req *http.Request) (*http.Response, error) {
+		return client.doLimited(req, httpClient)
+	}
+	if _, hasDeadline := req.Context().Deadline(); timeout <= 0 || hasDeadline {
+		return client.RetryPolicy.do(req, send)
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+	res, err := client.RetryPolicy.do(req.WithContext(ctx), send)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	res.Body = &cancelOnClose{ReadCloser: res.Body, cancel: cancel}
+	return res, nil
+}
+
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (body *cancelOnClose) Close() error {
+	defer body.cancel()
+	return body.ReadCloser.Close()
+}
+
+func (client *Client) doLimited(req *http.Request, httpClient *http.Client) (*http.Response, error) {
+	if client.Limiter != nil {
+		if err := client.Limiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if id := requestID(res.Header); id != "" {
+		client.lastRequestID.Store(&id)
+	}
+	return res, nil
+}
+
+// LastRequestID returns the request ID from the most recent ATC response that
+// had one. Quote it when asking the ATC operators about a failed request.
+func (client *Client) LastRequestID() string {
+	if id := client.lastRequestID.Load(); id != nil {
+		return *id
+	}
+	return ""
+}
+
+func requestID(header http.Header) string {
+	if id := header.Get("X-Concourse-Request-Id"); id != "" {
+		return id
+	}
+	return header.Get("X-Request-Id")
+}
+
+// doUnauthenticated is like Do but sends req without the authorization
+// header. It is used for endpoints the ATC serves to anonymous users.
+func (client *Client) doUnauthenticated(req *http.Request) (*http.Response, error) {
+	return client.do(req, client.Timeout, &client.unauthenticated)
 }
 
 func (client *Client) APIPath(segments ...string) string {
@@ -51,6 +200,15 @@ func (client *Client) loadEnvironment() {
 	if value, isSet := os.LookupEnv("CONCOURSE_PASSWORD"); isSet && client.Password == "" {
 		client.Password = value
 	}
+	if value, isSet := os.LookupEnv("CONCOURSE_TOKEN"); isSet && client.BearerToken == "" {
+		client.BearerToken = value
+	}
+	if client.BearerToken != "" {
+		client.token.Store(&oauth2.Token{
+			AccessToken: client.BearerToken,
+			TokenType:   "Bearer",
+		})
+	}
 }
 
 func (client *Client) setupClient() {
@@ -58,33 +216,173 @@ func (client *Client) setupClient() {
 	if base == nil {
 		base = http.DefaultTransport
 	}
-	client.Client = http.Client{
-		Transport: &oauth2.Transport{
-			Base:   base,
-			Source: client,
-		},
+	base, err := client.configureTransport(base)
+	if err != nil {
+		client.setupErr = err
+		return
+	}
+	if client.Middleware != nil {
+		base = client.Middleware(base)
+	}
+	// only the transports are replaced so the Timeout, CheckRedirect, and
+	// Jar of the http.Client still apply
+	client.unauthenticated = client.Client
+	client.unauthenticated.Transport = base
+	client.Client.Transport = &tokenTransport{
+		base:   base,
+		client: client,
 	}
 }
 
+// configureTransport applies the TLS and proxy fields to a copy of base. The
+// fields are applied here, rather than when they are set, because the
+// transport is constructed on the first request.
+func (client *Client) configureTransport(base http.RoundTripper) (http.RoundTripper, error) {
+	if client.TLSConfig == nil && client.RootCAs == nil && !client.InsecureSkipVerify && client.Proxy == nil {
+		return base, nil
+	}
+	transport, ok := base.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("the TLS and proxy fields can not be applied to a %T transport: use Middleware to wrap the transport", base)
+	}
+	transport = transport.Clone()
+	if client.Proxy != nil {
+		transport.Proxy = client.Proxy
+	}
+	if client.TLSConfig == nil && client.RootCAs == nil && !client.InsecureSkipVerify {
+		return transport, nil
+	}
+	if client.TLSConfig != nil {
+		transport.TLSClientConfig = client.TLSConfig.Clone()
+	}
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = new(tls.Config)
+	}
+	if client.RootCAs != nil {
+		transport.TLSClientConfig.RootCAs = client.RootCAs
+	}
+	if client.InsecureSkipVerify {
+		transport.TLSClientConfig.InsecureSkipVerify = true
+	}
+	return transport, nil
+}
+
+// tokenTransport sets the authorization header like oauth2.Transport but
+// fetches the token with the request context.
+type tokenTransport struct {
+	base   http.RoundTripper
+	client *Client
+}
+
+func (transport *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := transport.client.TokenContext(req.Context())
+	if err != nil {
+		if req.Body != nil {
+			closeAndIgnoreErr(req.Body)
+		}
+		return nil, err
+	}
+	authenticated := req.Clone(req.Context())
+	token.SetAuthHeader(authenticated)
+	return transport.base.RoundTrip(authenticated)
+}
+
 func (client *Client) Token() (*oauth2.Token, error) {
+	return client.TokenContext(context.Background())
+}
+
+func (client *Client) TokenContext(ctx context.Context) (*oauth2.Token, error) {
+	client.runLoadEnvironment.Do(client.loadEnvironment)
+	client.runSetupClient.Do(client.setupClient)
+	if client.setupErr != nil {
+		return nil, client.setupErr
+	}
+	if token := client.token.Load(); token != nil && token.Valid() {
+		return token, nil
+	}
+	// concurrent callers share a single token request. It is not canceled
+	// with the context of the caller that started it so the other callers
+	// still receive the token; each caller stops waiting when its own
+	// context is done.
+	results := client.tokenRequests.DoChan("", func() (any, error) {
+		ctx := context.WithoutCancel(ctx)
+		if client.Timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, client.Timeout)
+			defer cancel()
+		}
+		return client.fetchToken(ctx)
+	})
+	select {
+	case result := <-results:
+		if result.Err != nil {
+			return nil, result.Err
+		}
+		return result.Val.(*oauth2.Token), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (client *Client) fetchToken(ctx context.Context) (*oauth2.Token, error) {
 	token := client.token.Load()
-	if token == nil || !token.Valid() {
-		var err error
-		ctx := context.Background()
-		token, err = skyMarshalToken(ctx, client.URL, client.Username, client.Password)
-		if err != nil {
-			return nil, err
+	if token != nil && token.Valid() {
+		return token, nil
+	}
+	if client.TokenCache != nil {
+		if cached, err := client.TokenCache.Load(); err == nil && cached != nil {
+			if cached.Valid() {
+				client.token.Store(cached)
+				return cached, nil
+			}
+			if token == nil {
+				token = cached
+			}
 		}
-		client.token.Store(token)
+	}
+	// the sky issuer is requested with the same transport as the ATC
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, &client.unauthenticated)
+	token, err := skyMarshalToken(ctx, client.oauth2Configuration(), client.Username, client.Password, token)
+	if err != nil {
+		return nil, err
+	}
+	client.token.Store(token)
+	if client.TokenCache != nil {
+		// failing to cache the token should not fail the request
+		_ = client.TokenCache.Store(token)
+	}
+	if client.OnToken != nil {
+		client.OnToken(token)
 	}
 	return token, nil
 }
 
-func skyMarshalToken(ctx context.Context, host, username, password string) (*oauth2.Token, error) {
-	config := skyMarshalOAuth2Configuration(host)
+// skyMarshalToken uses the refresh token of the expired token when there is
+// one, and falls back to the password grant when there is not or when the
+// refresh fails.
+func skyMarshalToken(ctx context.Context, config oauth2.Config, username, password string, expired *oauth2.Token) (*oauth2.Token, error) {
+	if expired != nil && expired.RefreshToken != "" {
+		if token, err := config.TokenSource(ctx, expired).Token(); err == nil {
+			return token, nil
+		}
+	}
 	return config.PasswordCredentialsToken(ctx, username, password)
 }
 
+func (client *Client) oauth2Configuration() oauth2.Config {
+	config := skyMarshalOAuth2Configuration(client.URL)
+	if client.ClientID != "" {
+		config.ClientID = client.ClientID
+	}
+	if client.ClientSecret != "" {
+		config.ClientSecret = client.ClientSecret
+	}
+	if len(client.Scopes) > 0 {
+		config.Scopes = client.Scopes
+	}
+	return config
+}
+
 func skyMarshalOAuth2Configuration(host string) oauth2.Config {
 	return oauth2.Config{
 		ClientID:     "fly",
@@ -101,8 +399,42 @@ func closeAndIgnoreErr(c io.Closer) {
 }
 
 type Team struct {
-	ID   int    `json:"id"`
-	Name string `json:"name"`
+	ID   int      `json:"id"`
+	Name string   `json:"name"`
+	Auth TeamAuth `json:"auth"`
+}
+
+// TeamAuth configures which users and groups are granted each team role.
+type TeamAuth struct {
+	Owner            TeamRole `json:"owner"`
+	Member           TeamRole `json:"member"`
+	PipelineOperator TeamRole `json:"pipeline-operator"`
+	Viewer           TeamRole `json:"viewer"`
+}
+
+type TeamRole struct {
+	Users  []string `json:"users"`
+	Groups []string `json:"groups"`
+}
+
+func (role TeamRole) isEmpty() bool {
+	return len(role.Users) == 0 && len(role.Groups) == 0
+}
+
+// MarshalJSON omits roles without any users or groups.
+func (auth TeamAuth) MarshalJSON() ([]byte, error) {
+	roles := make(map[string]TeamRole)
+	for name, role := range map[string]TeamRole{
+		"owner":             auth.Owner,
+		"member":            auth.Member,
+		"pipeline-operator": auth.PipelineOperator,
+		"viewer":            auth.Viewer,
+	} {
+		if !role.isEmpty() {
+			roles[name] = role
+		}
+	}
+	return json.Marshal(roles)
 }
 
 type Pipeline struct {
@@ -120,18 +452,20 @@ func (pipeline Pipeline) LastUpdatedTime() time.Time {
 }
 
 type Resource struct {
-	Name         string `json:"name"`
-	Type         string `json:"type"`
-	PipelineID   int    `json:"pipeline_id"`
-	PipelineName string `json:"pipeline_name"`
-	TeamName     string `json:"team_name"`
-	LastChecked  int    `json:"last_checked"`
-	Build        struct {
+	Name          string          `json:"name"`
+	Type          string          `json:"type"`
+	PipelineID    int             `json:"pipeline_id"`
+	PipelineName  string          `json:"pipeline_name"`
+	TeamName      string          `json:"team_name"`
+	LastChecked   int64           `json:"last_checked"`
+	Paused        bool            `json:"paused"`
+	PinnedVersion json.RawMessage `json:"pinned_version,omitempty"`
+	Build         struct {
 		ID           int    `json:"id"`
 		Name         string `json:"name"`
 		Status       string `json:"status"`
-		StartTime    int    `json:"start_time"`
-		EndTime      int    `json:"end_time"`
+		StartTime    int64  `json:"start_time"`
+		EndTime      int64  `json:"end_time"`
 		TeamName     string `json:"team_name"`
 		PipelineId   int    `json:"pipeline_id"`
 		PipelineName string `json:"pipeline_name"`
@@ -145,6 +479,29 @@ type Resource struct {
 	} `json:"build"`
 }
 
+func (resource Resource) LastCheckedTime() time.Time {
+	return unixTime(resource.LastChecked)
+}
+
+type ResourceType struct {
+	Name        string          `json:"name"`
+	Type        string          `json:"type"`
+	Source      json.RawMessage `json:"source"`
+	Version     json.RawMessage `json:"version"`
+	LastChecked int64           `json:"last_checked"`
+}
+
+type ResourceCheck struct {
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	CreateTime int64  `json:"create_time"`
+	StartTime  int64  `json:"start_time"`
+	EndTime    int64  `json:"end_time"`
+	TeamName   string `json:"team_name"`
+	CheckError string `json:"check_error,omitempty"`
+}
+
 type Job struct {
 	ID              int      `json:"id"`
 	Name            string   `json:"name"`
@@ -155,6 +512,15 @@ type Job struct {
 	TransitionBuild Build    `json:"transition_build"`
 	Groups          []string `json:"groups"`
 	HasNewInputs    bool     `json:"has_new_inputs"`
+	Paused          bool     `json:"paused"`
+}
+
+type JobInput struct {
+	Name     string          `json:"name"`
+	Resource string          `json:"resource"`
+	Trigger  bool            `json:"trigger"`
+	Passed   []string        `json:"passed"`
+	Version  json.RawMessage `json:"version"`
 }
 
 type BuildInput struct {
@@ -178,22 +544,180 @@ type Build struct {
 	CreatedBy    string       `json:"created_by,omitempty"`
 }
 
+// Build statuses reported in Build.Status.
+const (
+	StatusPending   = "pending"
+	StatusStarted   = "started"
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+	StatusErrored   = "errored"
+	StatusAborted   = "aborted"
+)
+
+// IsFinished reports whether the build reached a terminal status.
+func (build Build) IsFinished() bool {
+	switch build.Status {
+	case StatusSucceeded, StatusFailed, StatusErrored, StatusAborted:
+		return true
+	default:
+		return false
+	}
+}
+
+func (build Build) IsSuccessful() bool {
+	return build.Status == StatusSucceeded
+}
+
+// Duration returns how long the build ran. It is zero until the build
+// finishes.
+func (build Build) Duration() time.Duration {
+	if build.StartTime == 0 || build.EndTime == 0 {
+		return 0
+	}
+	return time.Duration(build.EndTime-build.StartTime) * time.Second
+}
+
+// StartedAt returns the zero time when the build has not started.
+func (build Build) StartedAt() time.Time {
+	return unixTime(build.StartTime)
+}
+
+// FinishedAt returns the zero time when the build has not finished.
+func (build Build) FinishedAt() time.Time {
+	return unixTime(build.EndTime)
+}
+
+// unixTime converts a Concourse timestamp. The ATC sends 0 for times that
+// have not happened so it is converted to the zero time.
+func unixTime(seconds int64) time.Time {
+	if seconds == 0 {
+		return time.Time{}
+	}
+	return time.Unix(seconds, 0)
+}
+
+type BuildResources struct {
+	Inputs  []BuildInputResult  `json:"inputs"`
+	Outputs []BuildOutputResult `json:"outputs"`
+}
+
+type BuildInputResult struct {
+	Name     string          `json:"name"`
+	Resource string          `json:"resource"`
+	Type     string          `json:"type"`
+	Version  json.RawMessage `json:"version"`
+}
+
+type BuildOutputResult struct {
+	Name     string          `json:"name"`
+	Resource string          `json:"resource"`
+	Type     string          `json:"type"`
+	Version  json.RawMessage `json:"version"`
+}
+
+type BuildPreparation struct {
+	BuildID             int                               `json:"build_id"`
+	PausedPipeline      BuildPreparationStatus            `json:"paused_pipeline"`
+	PausedJob           BuildPreparationStatus            `json:"paused_job"`
+	MaxRunningBuilds    BuildPreparationStatus            `json:"max_running_builds"`
+	Inputs              map[string]BuildPreparationStatus `json:"inputs"`
+	InputsSatisfied     BuildPreparationStatus            `json:"inputs_satisfied"`
+	MissingInputReasons map[string]string                 `json:"missing_input_reasons"`
+}
+
+type BuildPreparationStatus string
+
+const (
+	BuildPreparationStatusUnknown     BuildPreparationStatus = "unknown"
+	BuildPreparationStatusBlocking    BuildPreparationStatus = "blocking"
+	BuildPreparationStatusNotBlocking BuildPreparationStatus = "not_blocking"
+)
+
 type ResourceVersion struct {
 	ID      int             `json:"id"`
 	Version json.RawMessage `json:"version"`
 	Enabled bool            `json:"enabled"`
 }
 
-type BuildEvent struct {
-	Data  BuildEventData `json:"data"`
-	Event string         `json:"event"`
+// VersionMap decodes Version as the flat string map most resource types
+// use. It returns an error for versions with other value types.
+func (version ResourceVersion) VersionMap() (map[string]string, error) {
+	if len(version.Version) == 0 {
+		return nil, nil
+	}
+	var result map[string]string
+	return result, json.Unmarshal(version.Version, &result)
 }
 
-type BuildEventData struct {
-	Payload string          `json:"payload"`
-	Time    int64           `json:"time"`
-	Origin  json.RawMessage `json:"origin"`
-	Message string          `json:"message"`
+type Worker struct {
+	Name             string   `json:"name"`
+	State            string   `json:"state"`
+	Platform         string   `json:"platform"`
+	Tags             []string `json:"tags"`
+	Team             string   `json:"team"`
+	ActiveContainers int      `json:"active_containers"`
+	ActiveVolumes    int      `json:"active_volumes"`
+	StartTime        int64    `json:"start_time"`
+}
+
+type Container struct {
+	ID           string `json:"id"`
+	WorkerName   string `json:"worker_name"`
+	Type         string `json:"type"`
+	PipelineName string `json:"pipeline_name"`
+	JobName      string `json:"job_name"`
+	BuildID      int    `json:"build_id"`
+	StepName     string `json:"step_name"`
+}
+
+// ContainerFilter narrows the containers returned by Containers. Empty fields
+// are not used to filter.
+type ContainerFilter struct {
+	PipelineName string
+	JobName      string
+	Type         string
+}
+
+// Volume is a volume on a worker. The ATC does not report volume sizes, so
+// there is no SizeInBytes; disk usage has to be read from the workers.
+// Volumes can still be attributed to workers with WorkerName.
+type Volume struct {
+	ID               string                  `json:"id"`
+	WorkerName       string                  `json:"worker_name"`
+	Type             string                  `json:"type"`
+	ContainerHandle  string                  `json:"container_handle"`
+	Path             string                  `json:"path"`
+	ParentHandle     string                  `json:"parent_handle"`
+	ResourceType     *VolumeResourceType     `json:"resource_type"`
+	BaseResourceType *VolumeBaseResourceType `json:"base_resource_type"`
+	PipelineID       int                     `json:"pipeline_id"`
+	PipelineName     string                  `json:"pipeline_name"`
+	JobName          string                  `json:"job_name"`
+	StepName         string                  `json:"step_name"`
+}
+
+// VolumeResourceType describes the resource cache of a resource volume. A
+// cache of a custom resource type nests the cache of that type in
+// ResourceType.
+type VolumeResourceType struct {
+	ResourceType     *VolumeResourceType     `json:"resource_type"`
+	BaseResourceType *VolumeBaseResourceType `json:"base_resource_type"`
+	Version          json.RawMessage         `json:"version"`
+}
+
+type VolumeBaseResourceType struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type UserInfo struct {
+	Sub      string              `json:"sub"`
+	Name     string              `json:"name"`
+	UserID   string              `json:"user_id"`
+	UserName string              `json:"user_name"`
+	Email    string              `json:"email"`
+	IsAdmin  bool                `json:"is_admin"`
+	Teams    map[string][]string `json:"teams"`
 }
 
 type Info struct {
@@ -204,12 +728,38 @@ type Info struct {
 	ClusterName   string          `json:"cluster_name"`
 }
 
+// CredsInfo holds the non-secret configuration of each credential manager
+// the ATC is configured with, keyed by manager name ("vault", "credhub",
+// "ssm", ...).
+type CredsInfo map[string]json.RawMessage
+
+// Managers returns the sorted names of the credential managers.
+func (info CredsInfo) Managers() []string {
+	return slices.Sorted(maps.Keys(info))
+}
+
+// Wall is the message the ATC shows to every user. ExpiresAt is zero when
+// the message does not expire.
+type Wall struct {
+	Message   string
+	ExpiresAt time.Time
+}
+
+// wall is the Wall the ATC sends and receives. TTL is the time left until
+// the message expires.
+type wall struct {
+	Message string        `json:"message,omitempty"`
+	TTL     time.Duration `json:"TTL,omitempty"`
+}
+
+// Info fetches the ATC and worker versions. The endpoint does not require
+// authentication so Info works without Username and Password set.
 func (client *Client) Info(ctx context.Context) (Info, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, client.APIPath("info"), nil)
 	if err != nil {
 		return Info{}, err
 	}
-	res, err := client.Do(req)
+	res, err := client.doUnauthenticated(req)
 	if err != nil {
 		return Info{}, err
 	}
@@ -219,40 +769,324 @@ func (client *Client) Info(ctx context.Context) (Info, error) {
 		return Info{}, err
 	}
 	if res.StatusCode != http.StatusOK {
-		return Info{}, &httpError{StatusCode: res.StatusCode, Body: body}
+		return Info{}, newHTTPError(res, body)
 	}
 	var info Info
 	return info, json.Unmarshal(body, &info)
 }
 
+// CredsInfo fetches the credential manager configuration. It requires an
+// admin user.
+func (client *Client) CredsInfo(ctx context.Context) (CredsInfo, error) {
+	return get[CredsInfo](ctx, client, "info", "creds")
+}
+
+// DownloadCLI copies the fly binary for the platform ("linux", "darwin", or
+// "windows") and arch ("amd64" or "arm64") to w. The endpoint does not
+// require authentication. When the ATC sends a Content-Length, a shorter
+// download is an error wrapping io.ErrUnexpectedEOF.
+func (client *Client) DownloadCLI(ctx context.Context, platform, arch string, w io.Writer) error {
+	u := client.APIPath("cli") + "?" + url.Values{"platform": {platform}, "arch": {arch}}.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	res, err := client.doUnauthenticated(req)
+	if err != nil {
+		return err
+	}
+	defer closeAndIgnoreErr(res.Body)
+	if res.StatusCode != http.StatusOK {
+		return readHTTPError(res)
+	}
+	n, err := io.Copy(w, res.Body)
+	if err != nil {
+		return err
+	}
+	if res.ContentLength >= 0 && n != res.ContentLength {
+		return fmt.Errorf("fly download received %d of %d bytes: %w", n, res.ContentLength, io.ErrUnexpectedEOF)
+	}
+	return nil
+}
+
+// Wall fetches the wall message. The endpoint does not require
+// authentication. The zero Wall is returned when no message is set.
+func (client *Client) Wall(ctx context.Context) (Wall, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, client.APIPath("wall"), nil)
+	if err != nil {
+		return Wall{}, err
+	}
+	res, err := client.doUnauthenticated(req)
+	if err != nil {
+		return Wall{}, err
+	}
+	defer closeAndIgnoreErr(res.Body)
+	if res.StatusCode != http.StatusOK {
+		return Wall{}, readHTTPError(res)
+	}
+	var result wall
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return Wall{}, err
+	}
+	w := Wall{Message: result.Message}
+	if result.TTL > 0 {
+		w.ExpiresAt = time.Now().Add(result.TTL)
+	}
+	return w, nil
+}
+
+func (client *Client) UserInfo(ctx context.Context) (UserInfo, error) {
+	return get[UserInfo](ctx, client, "user")
+}
+
 func (client *Client) Teams(ctx context.Context) ([]Team, error) {
 	return getList[Team](ctx, client, "teams")
 }
 
+func (client *Client) AllPipelines(ctx context.Context) ([]Pipeline, error) {
+	return getList[Pipeline](ctx, client, "pipelines")
+}
+
+// AllPipelinesByTeam lists the teams then fetches the pipelines of at most
+// concurrency teams at a time. The pipelines are keyed by team name. When
+// concurrency is less than one, the pipelines of every team are fetched at
+// once. The first error cancels the remaining requests.
+func (client *Client) AllPipelinesByTeam(ctx context.Context, concurrency int) (map[string][]Pipeline, error) {
+	teams, err := client.Teams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if concurrency < 1 {
+		concurrency = -1
+	}
+	var (
+		mu     sync.Mutex
+		result = make(map[string][]Pipeline, len(teams))
+	)
+	group, ctx := errgroup.WithContext(ctx)
+	group.SetLimit(concurrency)
+	for _, team := range teams {
+		group.Go(func() error {
+			pipelines, err := client.Pipelines(ctx, team.Name)
+			if err != nil {
+				return err
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			result[team.Name] = pipelines
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (client *Client) Pipeline(ctx context.Context, team, pipeline string) (Pipeline, error) {
+	return get[Pipeline](ctx, client, "teams", team, "pipelines", pipeline)
+}
+
 func (client *Client) Pipelines(ctx context.Context, team string) ([]Pipeline, error) {
 	return getList[Pipeline](ctx, client, "teams", team, "pipelines")
 }
 
+// AllResources lists the resources of every pipeline the user can see. The
+// ATC does not send resource sources; read them from PipelineConfig.
+func (client *Client) AllResources(ctx context.Context) ([]Resource, error) {
+	return getList[Resource](ctx, client, "resources")
+}
+
 func (client *Client) Resources(ctx context.Context, team, pipeline string) ([]Resource, error) {
 	return getList[Resource](ctx, client, "teams", team, "pipelines", pipeline, "resources")
 }
 
+func (client *Client) Resource(ctx context.Context, team, pipeline, resource string) (Resource, error) {
+	return get[Resource](ctx, client, "teams", team, "pipelines", pipeline, "resources", resource)
+}
+
 func (client *Client) ResourceVersions(ctx context.Context, team, pipeline, resource string) ([]ResourceVersion, error) {
 	return getList[ResourceVersion](ctx, client, "teams", team, "pipelines", pipeline, "resources", resource, "versions")
 }
 
+func (client *Client) ResourceTypes(ctx context.Context, team, pipeline string) ([]ResourceType, error) {
+	return getList[ResourceType](ctx, client, "teams", team, "pipelines", pipeline, "resource-types")
+}
+
 func (client *Client) Jobs(ctx context.Context, team, pipeline string) ([]Job, error) {
 	return getList[Job](ctx, client, "teams", team, "pipelines", pipeline, "jobs")
 }
 
+// AllJobs lists the jobs of every pipeline the user can see.
+func (client *Client) AllJobs(ctx context.Context) ([]Job, error) {
+	return getList[Job](ctx, client, "jobs")
+}
+
+// TeamJobs lists the jobs of every pipeline of the team. The ATC has no team
+// jobs endpoint so the jobs are fetched one pipeline at a time.
+func (client *Client) TeamJobs(ctx context.Context, team string) ([]Job, error) {
+	pipelines, err := client.Pipelines(ctx, team)
+	if err != nil {
+		return nil, err
+	}
+	var result []Job
+	for _, pipeline := range pipelines {
+		jobs, err := client.Jobs(ctx, team, pipeline.Name)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, jobs...)
+	}
+	return result, nil
+}
+
+func (client *Client) Job(ctx context.Context, team, pipeline, job string) (Job, error) {
+	return get[Job](ctx, client, "teams", team, "pipelines", pipeline, "jobs", job)
+}
+
+// JobStatuses returns the latest finished build of each job in the pipeline
+// keyed by job name. Jobs without a finished build use their transition
+// build. Jobs that have never been built map to the zero Build.
+func (client *Client) JobStatuses(ctx context.Context, team, pipeline string) (map[string]Build, error) {
+	jobs, err := client.Jobs(ctx, team, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]Build, len(jobs))
+	for _, job := range jobs {
+		build := job.FinishedBuild
+		if build.ID == 0 {
+			build = job.TransitionBuild
+		}
+		result[job.Name] = build
+	}
+	return result, nil
+}
+
+func (client *Client) JobInputs(ctx context.Context, team, pipeline, job string) ([]JobInput, error) {
+	return getList[JobInput](ctx, client, "teams", team, "pipelines", pipeline, "jobs", job, "inputs")
+}
+
+// JobBuilds returns the first page of builds of job. Use JobBuildsPage to
+// choose the page.
 func (client *Client) JobBuilds(ctx context.Context, team, pipeline, job string) ([]Build, error) {
-	return getList[Build](ctx, client, "teams", team, "pipelines", pipeline, "jobs", job, "builds")
+	builds, _, err := client.JobBuildsPage(ctx, team, pipeline, job, Page{})
+	return builds, err
 }
 
 func (client *Client) JobBuildsWithResourceVersion(ctx context.Context, team, pipeline, resource string, versionID int) ([]Build, error) {
 	return getList[Build](ctx, client, "teams", team, "pipelines", pipeline, "resources", resource, "versions", strconv.Itoa(versionID), "input_to")
 }
 
+func (client *Client) BuildsWithResourceVersionAsOutput(ctx context.Context, team, pipeline, resource string, versionID int) ([]Build, error) {
+	return getList[Build](ctx, client, "teams", team, "pipelines", pipeline, "resources", resource, "versions", strconv.Itoa(versionID), "output_of")
+}
+
+func (client *Client) Build(ctx context.Context, buildID int) (Build, error) {
+	return get[Build](ctx, client, "builds", strconv.Itoa(buildID))
+}
+
+func (client *Client) BuildResources(ctx context.Context, buildID int) (BuildResources, error) {
+	return get[BuildResources](ctx, client, "builds", strconv.Itoa(buildID), "resources")
+}
+
+// WaitForBuild polls the build every interval until it is finished. When
+// interval is not positive the build is polled every second. When ctx is
+// canceled first, the most recently fetched build is returned with the
+// context error.
+func (client *Client) WaitForBuild(ctx context.Context, buildID int, interval time.Duration) (Build, error) {
+	if interval <= 0 {
+		interval = time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		build, err := client.Build(ctx, buildID)
+		if err != nil {
+			return build, err
+		}
+		if build.IsFinished() {
+			return build, nil
+		}
+		select {
+		case <-ctx.Done():
+			return build, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (client *Client) BuildPreparation(ctx context.Context, buildID int) (BuildPreparation, error) {
+	return get[BuildPreparation](ctx, client, "builds", strconv.Itoa(buildID), "preparation")
+}
+
+// BuildPlan returns the raw plan of a build along with its schema. When the
+// plan is not available yet the error is a *NotFoundError.
+func (client *Client) BuildPlan(ctx context.Context, buildID int) (json.RawMessage, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, client.APIPath("builds", strconv.Itoa(buildID), "plan"), nil)
+	if err != nil {
+		return nil, "", err
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer closeAndIgnoreErr(res.Body)
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, "", err
+	}
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusAccepted:
+		return nil, "", &NotFoundError{HTTPError: HTTPError{StatusCode: res.StatusCode, Header: res.Header, Body: body, RequestID: requestID(res.Header)}}
+	default:
+		return nil, "", newHTTPError(res, body)
+	}
+	var plan struct {
+		Schema string          `json:"schema"`
+		Plan   json.RawMessage `json:"plan"`
+	}
+	if err := json.Unmarshal(body, &plan); err != nil {
+		return nil, "", err
+	}
+	return plan.Plan, plan.Schema, nil
+}
+
+func (client *Client) Workers(ctx context.Context) ([]Worker, error) {
+	return getList[Worker](ctx, client, "workers")
+}
+
+func (client *Client) Containers(ctx context.Context, team string, filter ContainerFilter) ([]Container, error) {
+	query := make(url.Values)
+	for key, value := range map[string]string{
+		"pipeline_name": filter.PipelineName,
+		"job_name":      filter.JobName,
+		"type":          filter.Type,
+	} {
+		if value != "" {
+			query.Set(key, value)
+		}
+	}
+	u := client.APIPath("teams", team, "containers")
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	return receive[[]Container](client, req)
+}
+
+func (client *Client) Container(ctx context.Context, team, handle string) (Container, error) {
+	return get[Container](ctx, client, "teams", team, "containers", handle)
+}
+
+func (client *Client) Volumes(ctx context.Context, team string) ([]Volume, error) {
+	return getList[Volume](ctx, client, "teams", team, "volumes")
+}
+
 func (client *Client) PipelineConfiguration(ctx context.Context, team, pipeline string) ([]byte, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, client.APIPath("teams", team, "pipelines", pipeline, "config"), nil)
 	if err != nil {
@@ -264,12 +1098,43 @@ func (client *Client) PipelineConfiguration(ctx context.Context, team, pipeline
 	}
 	defer closeAndIgnoreErr(res.Body)
 	if res.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(res.Body)
-		return nil, &httpError{StatusCode: res.StatusCode, Body: body}
+		return nil, readHTTPError(res)
 	}
 	return io.ReadAll(res.Body)
 }
 
+// ConfigVersionHeader carries the pipeline configuration version used for
+// optimistic concurrency when setting a pipeline configuration.
+const ConfigVersionHeader = "X-Concourse-Config-Version"
+
+// PipelineConfig returns the pipeline configuration from the "config" field
+// of the ATC response along with the configuration version.
+func (client *Client) PipelineConfig(ctx context.Context, team, pipeline string) (config []byte, version string, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, client.APIPath("teams", team, "pipelines", pipeline, "config"), nil)
+	if err != nil {
+		return nil, "", err
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer closeAndIgnoreErr(res.Body)
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, "", err
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, "", newHTTPError(res, body)
+	}
+	var envelope struct {
+		Config json.RawMessage `json:"config"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil, "", err
+	}
+	return envelope.Config, res.Header.Get(ConfigVersionHeader), nil
+}
+
 func (client *Client) SetPipelineConfiguration(ctx context.Context, team, pipeline string, configuration []byte) error {
 	req, err := http.NewRequestWithContext(ctx, http.MethodPut, client.APIPath("teams", team, "pipelines", pipeline, "config"), bytes.NewReader(configuration))
 	if err != nil {
@@ -282,92 +1147,495 @@ func (client *Client) SetPipelineConfiguration(ctx context.Context, team, pipeli
 	}
 	defer closeAndIgnoreErr(res.Body)
 	if res.StatusCode >= http.StatusBadRequest {
-		body, _ := io.ReadAll(res.Body)
-		return &httpError{StatusCode: res.StatusCode, Body: body}
+		return readHTTPError(res)
 	}
 	return nil
 }
 
-func (client *Client) DestroyPipeline(ctx context.Context, team, pipeline string) error {
-	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, client.APIPath("teams", team, "pipelines", pipeline), nil)
+// SetPipelineConfig saves config if version matches the current configuration
+// version on the ATC. When the ATC rejects the configuration, for example
+// because version is stale, the returned error is a *ConfigError.
+func (client *Client) SetPipelineConfig(ctx context.Context, team, pipeline string, config []byte, version string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, client.APIPath("teams", team, "pipelines", pipeline, "config"), bytes.NewReader(config))
 	if err != nil {
 		return err
 	}
+	req.Header.Set("content-type", "application/x-yaml")
+	req.Header.Set(ConfigVersionHeader, version)
 	res, err := client.Do(req)
 	if err != nil {
 		return err
 	}
 	defer closeAndIgnoreErr(res.Body)
+	if res.StatusCode < http.StatusBadRequest {
+		return nil
+	}
+	body, _ := io.ReadAll(res.Body)
+	if res.StatusCode == http.StatusBadRequest {
+		var response struct {
+			Errors   []string        `json:"errors"`
+			Warnings []ConfigWarning `json:"warnings"`
+		}
+		if json.Unmarshal(body, &response) == nil {
+			return &ConfigError{
+				HTTPError: HTTPError{StatusCode: res.StatusCode, Header: res.Header, Body: body, RequestID: requestID(res.Header)},
+				Errors:    response.Errors,
+				Warnings:  response.Warnings,
+			}
+		}
+	}
+	return newHTTPError(res, body)
+}
+
+type ConfigWarning struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+// ConfigError is returned when the ATC refuses to save a pipeline
+// configuration.
+type ConfigError struct {
+	HTTPError
+	Errors   []string
+	Warnings []ConfigWarning
+}
+
+func (err *ConfigError) Unwrap() error { return &err.HTTPError }
+
+func (client *Client) DeletePipeline(ctx context.Context, team, pipeline string) error {
+	return send(ctx, client, http.MethodDelete, "teams", team, "pipelines", pipeline)
+}
+
+// Deprecated: use DeletePipeline.
+func (client *Client) DestroyPipeline(ctx context.Context, team, pipeline string) error {
+	return client.DeletePipeline(ctx, team, pipeline)
+}
+
+func (client *Client) PausePipeline(ctx context.Context, team, pipeline string) error {
+	return send(ctx, client, http.MethodPut, "teams", team, "pipelines", pipeline, "pause")
+}
+
+func (client *Client) UnpausePipeline(ctx context.Context, team, pipeline string) error {
+	return send(ctx, client, http.MethodPut, "teams", team, "pipelines", pipeline, "unpause")
+}
+
+func (client *Client) ExposePipeline(ctx context.Context, team, pipeline string) error {
+	return send(ctx, client, http.MethodPut, "teams", team, "pipelines", pipeline, "expose")
+}
+
+func (client *Client) HidePipeline(ctx context.Context, team, pipeline string) error {
+	return send(ctx, client, http.MethodPut, "teams", team, "pipelines", pipeline, "hide")
+}
+
+func (client *Client) RenamePipeline(ctx context.Context, team, oldName, newName string) error {
+	if newName == "" {
+		return errors.New("new pipeline name must not be empty")
+	}
+	return sendJSON(ctx, client, http.MethodPut, rename{Name: newName}, "teams", team, "pipelines", oldName, "rename")
+}
+
+type rename struct {
+	Name string `json:"name"`
+}
+
+func (client *Client) OrderPipelines(ctx context.Context, team string, names []string) error {
+	if len(names) == 0 {
+		return errors.New("pipeline names must not be empty")
+	}
+	return sendJSON(ctx, client, http.MethodPut, names, "teams", team, "pipelines", "ordering")
+}
+
+// ErrPipelineNotPaused is returned by ArchivePipeline when the ATC requires
+// the pipeline be paused before it is archived.
+var ErrPipelineNotPaused = errors.New("pipeline must be paused before it is archived")
+
+func (client *Client) ArchivePipeline(ctx context.Context, team, pipeline string) error {
+	err := send(ctx, client, http.MethodPut, "teams", team, "pipelines", pipeline, "archive")
+	var conflict *ConflictError
+	if errors.As(err, &conflict) {
+		return fmt.Errorf("%w: %w", ErrPipelineNotPaused, err)
+	}
+	return err
+}
+
+func (client *Client) PauseJob(ctx context.Context, team, pipeline, job string) error {
+	return send(ctx, client, http.MethodPut, "teams", team, "pipelines", pipeline, "jobs", job, "pause")
+}
+
+func (client *Client) UnpauseJob(ctx context.Context, team, pipeline, job string) error {
+	return send(ctx, client, http.MethodPut, "teams", team, "pipelines", pipeline, "jobs", job, "unpause")
+}
+
+func (client *Client) RenameJob(ctx context.Context, team, pipeline, oldName, newName string) error {
+	if newName == "" {
+		return errors.New("new job name must not be empty")
+	}
+	return sendJSON(ctx, client, http.MethodPut, rename{Name: newName}, "teams", team, "pipelines", pipeline, "jobs", oldName, "rename")
+}
+
+// CreateJobBuild triggers a new build of job. The ATC responds with a
+// *ForbiddenError when the job is paused.
+func (client *Client) CreateJobBuild(ctx context.Context, team, pipeline, job string) (Build, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, client.APIPath("teams", team, "pipelines", pipeline, "jobs", job, "builds"), nil)
+	if err != nil {
+		return Build{}, err
+	}
+	return receive[Build](client, req)
+}
+
+// ClearTaskCache removes the caches of the task step of the job and returns
+// how many were removed. When cachePath is empty every cache of the step is
+// removed.
+func (client *Client) ClearTaskCache(ctx context.Context, team, pipeline, job, step, cachePath string) (int, error) {
+	u := client.APIPath("teams", team, "pipelines", pipeline, "jobs", job, "tasks", step, "cache")
+	if cachePath != "" {
+		u += "?" + url.Values{"cachePath": {cachePath}}.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return 0, err
+	}
+	result, err := receive[struct {
+		CachesRemoved int `json:"caches_removed"`
+	}](client, req)
+	return result.CachesRemoved, err
+}
+
+func (client *Client) PauseResource(ctx context.Context, team, pipeline, resource string) error {
+	return send(ctx, client, http.MethodPut, "teams", team, "pipelines", pipeline, "resources", resource, "pause")
+}
+
+func (client *Client) UnpauseResource(ctx context.Context, team, pipeline, resource string) error {
+	return send(ctx, client, http.MethodPut, "teams", team, "pipelines", pipeline, "resources", resource, "unpause")
+}
+
+func (client *Client) EnableResourceVersion(ctx context.Context, team, pipeline, resource string, versionID int) error {
+	return send(ctx, client, http.MethodPut, "teams", team, "pipelines", pipeline, "resources", resource, "versions", strconv.Itoa(versionID), "enable")
+}
+
+func (client *Client) DisableResourceVersion(ctx context.Context, team, pipeline, resource string, versionID int) error {
+	return send(ctx, client, http.MethodPut, "teams", team, "pipelines", pipeline, "resources", resource, "versions", strconv.Itoa(versionID), "disable")
+}
+
+func (client *Client) PinResourceVersion(ctx context.Context, team, pipeline, resource string, versionID int) error {
+	return send(ctx, client, http.MethodPut, "teams", team, "pipelines", pipeline, "resources", resource, "versions", strconv.Itoa(versionID), "pin")
+}
+
+func (client *Client) UnpinResourceVersion(ctx context.Context, team, pipeline, resource string) error {
+	return send(ctx, client, http.MethodPut, "teams", team, "pipelines", pipeline, "resources", resource, "unpin")
+}
+
+// SetPinComment sets the comment on the pinned version of resource. The ATC
+// responds with a *NotFoundError when the resource is not pinned.
+func (client *Client) SetPinComment(ctx context.Context, team, pipeline, resource, comment string) error {
+	var body struct {
+		PinComment string `json:"pin_comment"`
+	}
+	body.PinComment = comment
+	return sendJSON(ctx, client, http.MethodPut, body, "teams", team, "pipelines", pipeline, "resources", resource, "pin_comment")
+}
+
+// CheckResource starts a check of resource. When from is nil the check
+// starts from the latest version.
+func (client *Client) CheckResource(ctx context.Context, team, pipeline, resource string, from json.RawMessage) (ResourceCheck, error) {
+	var body struct {
+		From json.RawMessage `json:"from,omitempty"`
+	}
+	body.From = from
+	req, err := newJSONRequest(ctx, client, http.MethodPost, body, "teams", team, "pipelines", pipeline, "resources", resource, "check")
+	if err != nil {
+		return ResourceCheck{}, err
+	}
+	return receive[ResourceCheck](client, req)
+}
+
+// CheckResourceWebhook triggers a resource check with the webhook token
+// configured on the resource. The ATC authorizes the request with the
+// token, so it is sent without the Client token and works without Username
+// and Password set.
+func (client *Client) CheckResourceWebhook(ctx context.Context, team, pipeline, resource, token string) error {
+	u := client.APIPath("teams", team, "pipelines", pipeline, "resources", resource, "check", "webhook")
+	u += "?" + url.Values{"webhook_token": {token}}.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, nil)
+	if err != nil {
+		return err
+	}
+	res, err := client.doUnauthenticated(req)
+	if err != nil {
+		return err
+	}
+	defer closeAndIgnoreErr(res.Body)
 	if res.StatusCode >= http.StatusBadRequest {
-		body, _ := io.ReadAll(res.Body)
-		return &httpError{StatusCode: res.StatusCode, Body: body}
+		return readHTTPError(res)
 	}
 	return nil
 }
 
-func (client *Client) BuildEvents(ctx context.Context, buildID int) (<-chan BuildEvent, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, client.APIPath("builds", strconv.Itoa(buildID), "events"), nil)
-	if err != nil {
-		return nil, err
+func (client *Client) AbortBuild(ctx context.Context, buildID int) error {
+	return send(ctx, client, http.MethodPut, "builds", strconv.Itoa(buildID), "abort")
+}
+
+// ErrWorkerRunning is returned by PruneWorker when the worker is still
+// running. Only stalled workers may be pruned.
+var ErrWorkerRunning = errors.New("worker is running and can not be pruned")
+
+func (client *Client) PruneWorker(ctx context.Context, name string) error {
+	err := send(ctx, client, http.MethodPut, "workers", name, "prune")
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusBadRequest {
+		return fmt.Errorf("%w: %w", ErrWorkerRunning, err)
 	}
-	res, err := client.Do(req)
+	return err
+}
+
+func (client *Client) LandWorker(ctx context.Context, name string) error {
+	return send(ctx, client, http.MethodPut, "workers", name, "land")
+}
+
+func (client *Client) RetireWorker(ctx context.Context, name string) error {
+	return send(ctx, client, http.MethodPut, "workers", name, "retire")
+}
+
+// SetTeam creates the team or updates its auth configuration if it exists.
+func (client *Client) SetTeam(ctx context.Context, name string, auth TeamAuth) (Team, error) {
+	req, err := newJSONRequest(ctx, client, http.MethodPut, Team{Name: name, Auth: auth}, "teams", name)
 	if err != nil {
-		return nil, err
+		return Team{}, err
 	}
-	if res.StatusCode != http.StatusOK {
-		return nil, &httpError{StatusCode: res.StatusCode}
+	return receive[Team](client, req)
+}
+
+// SetDefaultTeamAuth replaces the roles of the main team. It requires an
+// admin user. The main team must keep an owner, so auth without any owner
+// users or groups is an error and is not sent.
+func (client *Client) SetDefaultTeamAuth(ctx context.Context, auth TeamAuth) error {
+	if auth.Owner.isEmpty() {
+		return errors.New("main team auth must have an owner user or group")
 	}
-	rc := sse.NewReadCloser(res.Body)
-	c := make(chan BuildEvent)
-	go sendBuildEvents(ctx, c, rc)
-	return c, nil
+	_, err := client.SetTeam(ctx, "main", auth)
+	return err
 }
 
-func sendBuildEvents(ctx context.Context, c chan<- BuildEvent, rc *sse.ReadCloser) {
-	defer close(c)
-	for {
-		if err := ctx.Err(); err != nil {
-			closeAndIgnoreErr(rc)
-			return
-		}
-		event, err := rc.Next()
-		if err != nil || event.Name == "end" {
-			return
-		}
-		var message BuildEvent
-		if err := json.Unmarshal(event.Data, &message); err != nil {
-			continue
+// DeleteTeam destroys the team and its pipelines. The ATC responds with a
+// *ForbiddenError when the team is the last remaining team or when the user
+// is not an admin.
+func (client *Client) DeleteTeam(ctx context.Context, name string) error {
+	return send(ctx, client, http.MethodDelete, "teams", name)
+}
+
+func (client *Client) RenameTeam(ctx context.Context, oldName, newName string) error {
+	if newName == "" {
+		return errors.New("new team name must not be empty")
+	}
+	return sendJSON(ctx, client, http.MethodPut, rename{Name: newName}, "teams", oldName, "rename")
+}
+
+// SetWall sets the wall message. It requires an admin user. ExpiresAt is
+// sent as the time left until it, so an ExpiresAt in the past is an error.
+func (client *Client) SetWall(ctx context.Context, w Wall) error {
+	body := wall{Message: w.Message}
+	if !w.ExpiresAt.IsZero() {
+		body.TTL = time.Until(w.ExpiresAt)
+		if body.TTL <= 0 {
+			return errors.New("wall expiration must be in the future")
 		}
-		c <- message
 	}
+	return sendJSON(ctx, client, http.MethodPut, body, "wall")
+}
+
+// ClearWall removes the wall message. It requires an admin user.
+func (client *Client) ClearWall(ctx context.Context) error {
+	return send(ctx, client, http.MethodDelete, "wall")
 }
 
 func getList[T any](ctx context.Context, client *Client, segments ...string) ([]T, error) {
+	return get[[]T](ctx, client, segments...)
+}
+
+func get[T any](ctx context.Context, client *Client, segments ...string) (T, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, client.APIPath(segments...), nil)
 	if err != nil {
-		return nil, err
+		var zero T
+		return zero, err
 	}
+	return receive[T](client, req)
+}
+
+// receive makes the request and decodes a successful JSON response body.
+func receive[T any](client *Client, req *http.Request) (T, error) {
+	var result T
 	res, err := client.Do(req)
 	if err != nil {
-		return nil, err
+		return result, err
 	}
 	defer closeAndIgnoreErr(res.Body)
-	body, err := io.ReadAll(res.Body)
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		return result, readHTTPError(res)
+	}
+	// decoding from the body avoids holding both the raw and decoded
+	// response in memory
+	return result, json.NewDecoder(res.Body).Decode(&result)
+}
+
+// send makes a request without a body and discards the response body.
+func send(ctx context.Context, client *Client, method string, segments ...string) error {
+	req, err := http.NewRequestWithContext(ctx, method, client.APIPath(segments...), nil)
+	if err != nil {
+		return err
+	}
+	return sendRequest(client, req)
+}
+
+// sendJSON makes a request with payload encoded as the JSON body and
+// discards the response body.
+func sendJSON(ctx context.Context, client *Client, method string, payload any, segments ...string) error {
+	req, err := newJSONRequest(ctx, client, method, payload, segments...)
+	if err != nil {
+		return err
+	}
+	return sendRequest(client, req)
+}
+
+func newJSONRequest(ctx context.Context, client *Client, method string, payload any, segments ...string) (*http.Request, error) {
+	buf, err := json.Marshal(payload)
 	if err != nil {
 		return nil, err
 	}
-	if res.StatusCode != http.StatusOK {
-		return nil, &httpError{StatusCode: res.StatusCode, Body: body}
+	req, err := http.NewRequestWithContext(ctx, method, client.APIPath(segments...), bytes.NewReader(buf))
+	if err != nil {
+		return nil, err
 	}
-	var result []T
-	return result, json.Unmarshal(body, &result)
+	req.Header.Set("content-type", "application/json")
+	return req, nil
 }
 
-type httpError struct {
+func sendRequest(client *Client, req *http.Request) error {
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer closeAndIgnoreErr(res.Body)
+	if res.StatusCode >= http.StatusBadRequest {
+		return readHTTPError(res)
+	}
+	return nil
+}
+
+// HTTPError is returned when the ATC responds with an error status. The
+// typed errors, such as *NotFoundError, wrap it, so errors.As with an
+// *HTTPError reaches the response of any failed request.
+type HTTPError struct {
 	StatusCode int
+	Header     http.Header
 	Body       []byte
+	RequestID  string
 }
 
-func (err *httpError) Error() string {
-	return fmt.Sprintf("http error: %d: %s", err.StatusCode, err.Body)
+func (err *HTTPError) Status() int {
+	return err.StatusCode
+}
+
+func (err *HTTPError) Error() string {
+	if err.RequestID != "" {
+		return fmt.Sprintf("http error: %d: %s (request id: %s)", err.StatusCode, err.Message(), err.RequestID)
+	}
+	return fmt.Sprintf("http error: %d: %s", err.StatusCode, err.Message())
 }
+
+// Message returns the error message from a JSON response body or the raw
+// body when it is not JSON.
+func (err *HTTPError) Message() string {
+	var body struct {
+		Error  string   `json:"error"`
+		Errors []string `json:"errors"`
+	}
+	if json.Unmarshal(err.Body, &body) == nil {
+		switch {
+		case body.Error != "":
+			return body.Error
+		case len(body.Errors) > 0:
+			return strings.Join(body.Errors, "; ")
+		}
+	}
+	return string(err.Body)
+}
+
+// maxPooledBodySize limits the buffers kept in bodyBuffers so one large
+// error response does not stay in memory.
+const maxPooledBodySize = 64 << 10
+
+var bodyBuffers = sync.Pool{
+	New: func() any { return new(bytes.Buffer) },
+}
+
+// readHTTPError reads the error response body into a pooled buffer so only
+// the body kept by the error is allocated.
+func readHTTPError(res *http.Response) error {
+	buf := bodyBuffers.Get().(*bytes.Buffer)
+	defer func() {
+		if buf.Cap() <= maxPooledBodySize {
+			buf.Reset()
+			bodyBuffers.Put(buf)
+		}
+	}()
+	_, _ = buf.ReadFrom(res.Body)
+	return newHTTPError(res, bytes.Clone(buf.Bytes()))
+}
+
+func newHTTPError(res *http.Response, body []byte) error {
+	err := HTTPError{StatusCode: res.StatusCode, Header: res.Header, Body: body, RequestID: requestID(res.Header)}
+	switch res.StatusCode {
+	case http.StatusUnauthorized:
+		return &UnauthorizedError{HTTPError: err}
+	case http.StatusForbidden:
+		return &ForbiddenError{HTTPError: err}
+	case http.StatusNotFound:
+		return &NotFoundError{HTTPError: err}
+	case http.StatusConflict:
+		return &ConflictError{HTTPError: err}
+	case http.StatusTooManyRequests:
+		retryAfter, _ := retryAfter(res.Header.Get("Retry-After"))
+		return &RateLimitedError{HTTPError: err, RetryAfter: retryAfter}
+	default:
+		return &err
+	}
+}
+
+// UnauthorizedError is returned when the ATC does not accept the token.
+type UnauthorizedError struct {
+	HTTPError
+}
+
+func (err *UnauthorizedError) Unwrap() error { return &err.HTTPError }
+
+// ForbiddenError is returned when the authenticated user lacks permission
+// to perform the request.
+type ForbiddenError struct {
+	HTTPError
+}
+
+func (err *ForbiddenError) Unwrap() error { return &err.HTTPError }
+
+// NotFoundError is returned when the requested object does not exist.
+type NotFoundError struct {
+	HTTPError
+}
+
+func (err *NotFoundError) Unwrap() error { return &err.HTTPError }
+
+// ConflictError is returned when the request conflicts with the current
+// state of the object on the ATC.
+type ConflictError struct {
+	HTTPError
+}
+
+func (err *ConflictError) Unwrap() error { return &err.HTTPError }
+
+// RateLimitedError is returned when the ATC is rate limiting requests.
+// RetryAfter is zero when the ATC did not send a Retry-After header.
+type RateLimitedError struct {
+	HTTPError
+	RetryAfter time.Duration
+}
+
+func (err *RateLimitedError) Unwrap() error { return &err.HTTPError }
@@ -1,11 +1,30 @@
 package glide_test
 
 import (
+	"bytes"
 	"context"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
 
 	"github.com/crhntr/glide"
+	"github.com/crhntr/glide/glidetest"
+	"golang.org/x/time/rate"
 )
 
 func Example() {
@@ -33,3 +52,1325 @@ func Example() {
 		}
 	}
 }
+
+func TestClient_Info(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/info", func(res http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			t.Errorf("unexpected method: %s", req.Method)
+		}
+		if auth := req.Header.Get("Authorization"); auth != "" {
+			t.Errorf("unexpected authorization header: %q", auth)
+		}
+		_, _ = io.WriteString(res, `{"version":"7.11.2","worker_version":"2.5","external_url":"https://ci.example.com","cluster_name":"example"}`)
+	})
+	mux.HandleFunc("/sky/issuer/token", func(res http.ResponseWriter, req *http.Request) {
+		t.Error("info must not request a token")
+		res.WriteHeader(http.StatusUnauthorized)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	concourse := glide.Client{URL: server.URL}
+	info, err := concourse.Info(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Version != "7.11.2" || info.WorkerVersion != "2.5" || info.ExternalURL != "https://ci.example.com" || info.ClusterName != "example" {
+		t.Errorf("unexpected info: %#v", info)
+	}
+}
+
+func TestClient_Pipeline(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/teams/main/pipelines/deploy", func(res http.ResponseWriter, req *http.Request) {
+		_, _ = io.WriteString(res, `{"id":1,"name":"deploy","paused":true,"public":false,"archived":false,"team_name":"main"}`)
+	})
+	mux.HandleFunc("/sky/issuer/token", writeToken)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.Client{URL: server.URL}
+
+	t.Run("found", func(t *testing.T) {
+		pipeline, err := concourse.Pipeline(context.Background(), "main", "deploy")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pipeline.Name != "deploy" || !pipeline.Paused || pipeline.TeamName != "main" {
+			t.Errorf("unexpected pipeline: %#v", pipeline)
+		}
+	})
+	t.Run("not found", func(t *testing.T) {
+		_, err := concourse.Pipeline(context.Background(), "main", "missing")
+		var notFound *glide.NotFoundError
+		if !errors.As(err, &notFound) {
+			t.Fatalf("expected a not found error got: %v", err)
+		}
+	})
+}
+
+func writeToken(res http.ResponseWriter, _ *http.Request) {
+	res.Header().Set("content-type", "application/json")
+	_, _ = io.WriteString(res, `{"access_token":"fake-token","token_type":"bearer","expires_in":3600}`)
+}
+
+func TestClient_DeletePipeline(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/teams/main/pipelines/deploy", func(res http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodDelete {
+			t.Errorf("unexpected method: %s", req.Method)
+		}
+		res.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/sky/issuer/token", writeToken)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.Client{URL: server.URL}
+
+	t.Run("deleted", func(t *testing.T) {
+		if err := concourse.DeletePipeline(context.Background(), "main", "deploy"); err != nil {
+			t.Fatal(err)
+		}
+	})
+	t.Run("not found", func(t *testing.T) {
+		err := concourse.DeletePipeline(context.Background(), "main", "missing")
+		var notFound *glide.NotFoundError
+		if !errors.As(err, &notFound) {
+			t.Fatalf("expected a not found error got: %v", err)
+		}
+	})
+}
+
+func TestClient_ExposePipeline(t *testing.T) {
+	var public atomic.Bool
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/teams/main/pipelines/deploy", func(res http.ResponseWriter, req *http.Request) {
+		_, _ = fmt.Fprintf(res, `{"id":1,"name":"deploy","public":%t,"team_name":"main"}`, public.Load())
+	})
+	setPublic := func(value bool) http.HandlerFunc {
+		return func(res http.ResponseWriter, req *http.Request) {
+			if req.Method != http.MethodPut {
+				t.Errorf("unexpected method: %s", req.Method)
+				res.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			public.Store(value)
+		}
+	}
+	mux.HandleFunc("/api/v1/teams/main/pipelines/deploy/expose", setPublic(true))
+	mux.HandleFunc("/api/v1/teams/main/pipelines/deploy/hide", setPublic(false))
+	mux.HandleFunc("/sky/issuer/token", writeToken)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.Client{URL: server.URL}
+	ctx := context.Background()
+
+	if err := concourse.ExposePipeline(ctx, "main", "deploy"); err != nil {
+		t.Fatal(err)
+	}
+	pipeline, err := concourse.Pipeline(ctx, "main", "deploy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pipeline.Public {
+		t.Error("expected pipeline to be public after expose")
+	}
+
+	if err := concourse.HidePipeline(ctx, "main", "deploy"); err != nil {
+		t.Fatal(err)
+	}
+	pipeline, err = concourse.Pipeline(ctx, "main", "deploy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pipeline.Public {
+		t.Error("expected pipeline to be hidden after hide")
+	}
+}
+
+func TestClient_AbortBuild(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/builds/42/abort", func(res http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPut {
+			t.Errorf("unexpected method: %s", req.Method)
+		}
+	})
+	mux.HandleFunc("/sky/issuer/token", writeToken)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.Client{URL: server.URL}
+
+	t.Run("aborted", func(t *testing.T) {
+		if err := concourse.AbortBuild(context.Background(), 42); err != nil {
+			t.Fatal(err)
+		}
+	})
+	t.Run("not found", func(t *testing.T) {
+		err := concourse.AbortBuild(context.Background(), 13)
+		var notFound *glide.NotFoundError
+		if !errors.As(err, &notFound) {
+			t.Fatalf("expected a not found error got: %v", err)
+		}
+	})
+}
+
+func TestClient_LandWorker(t *testing.T) {
+	testWorkerAction(t, "/api/v1/workers/worker-0/land", (*glide.Client).LandWorker)
+}
+
+func TestClient_RetireWorker(t *testing.T) {
+	testWorkerAction(t, "/api/v1/workers/worker-0/retire", (*glide.Client).RetireWorker)
+}
+
+func testWorkerAction(t *testing.T, expectedPath string, action func(*glide.Client, context.Context, string) error) {
+	t.Helper()
+	var requestedPath string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/workers/", func(res http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPut {
+			t.Errorf("unexpected method: %s", req.Method)
+		}
+		requestedPath = req.URL.Path
+	})
+	mux.HandleFunc("/sky/issuer/token", writeToken)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := &glide.Client{URL: server.URL}
+
+	if err := action(concourse, context.Background(), "worker-0"); err != nil {
+		t.Fatal(err)
+	}
+	if requestedPath != expectedPath {
+		t.Errorf("unexpected path: got %q want %q", requestedPath, expectedPath)
+	}
+}
+
+func TestClient_Token_refresh(t *testing.T) {
+	var grantTypes []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/sky/issuer/token", func(res http.ResponseWriter, req *http.Request) {
+		grantTypes = append(grantTypes, req.FormValue("grant_type"))
+		res.Header().Set("content-type", "application/json")
+		_, _ = io.WriteString(res, `{"access_token":"fake-token","refresh_token":"fake-refresh-token","token_type":"bearer","expires_in":1}`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.Client{URL: server.URL, Username: "admin", Password: "password"}
+
+	for i := 0; i < 2; i++ {
+		if _, err := concourse.Token(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if fmt.Sprint(grantTypes) != "[password refresh_token]" {
+		t.Errorf("unexpected grant types: %v", grantTypes)
+	}
+}
+
+func TestClient_BearerToken(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/teams", func(res http.ResponseWriter, req *http.Request) {
+		if auth := req.Header.Get("Authorization"); auth != "Bearer some-token" {
+			t.Errorf("unexpected authorization header: %q", auth)
+		}
+		_, _ = io.WriteString(res, `[{"id":1,"name":"main"}]`)
+	})
+	mux.HandleFunc("/sky/issuer/token", func(res http.ResponseWriter, req *http.Request) {
+		t.Error("the bearer token must be used without requesting a token")
+		res.WriteHeader(http.StatusUnauthorized)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.Client{URL: server.URL, BearerToken: "some-token"}
+
+	if _, err := concourse.Teams(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_Token_concurrent(t *testing.T) {
+	var tokenRequests atomic.Int64
+	mux := http.NewServeMux()
+	mux.HandleFunc("/sky/issuer/token", func(res http.ResponseWriter, req *http.Request) {
+		tokenRequests.Add(1)
+		time.Sleep(10 * time.Millisecond)
+		writeToken(res, req)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.Client{URL: server.URL}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := concourse.Token(); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if count := tokenRequests.Load(); count != 1 {
+		t.Errorf("expected the issuer to be called once got %d", count)
+	}
+}
+
+func TestClient_TokenContext_leaderCanceled(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	mux := http.NewServeMux()
+	mux.HandleFunc("/sky/issuer/token", func(res http.ResponseWriter, req *http.Request) {
+		close(started)
+		<-release
+		writeToken(res, req)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.Client{URL: server.URL}
+
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+	leaderErr := make(chan error)
+	go func() {
+		_, err := concourse.TokenContext(leaderCtx)
+		leaderErr <- err
+	}()
+	<-started
+
+	waiterErr := make(chan error)
+	go func() {
+		token, err := concourse.TokenContext(context.Background())
+		if err == nil && token.AccessToken != "fake-token" {
+			err = fmt.Errorf("unexpected token: %q", token.AccessToken)
+		}
+		waiterErr <- err
+	}()
+	// give the waiter time to join the token request
+	time.Sleep(10 * time.Millisecond)
+
+	cancelLeader()
+	if err := <-leaderErr; !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the leader to be canceled got: %v", err)
+	}
+	close(release)
+	if err := <-waiterErr; err != nil {
+		t.Errorf("expected the waiter to receive the token got: %v", err)
+	}
+}
+
+func TestClient_InsecureSkipVerify(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		_, _ = io.WriteString(res, `{"version":"7.11.2"}`)
+	}))
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	server.StartTLS()
+	defer server.Close()
+
+	t.Run("verified", func(t *testing.T) {
+		concourse := glide.Client{URL: server.URL}
+		if _, err := concourse.Info(context.Background()); err == nil {
+			t.Fatal("expected a certificate error")
+		}
+	})
+	t.Run("skipped", func(t *testing.T) {
+		concourse := glide.Client{URL: server.URL, InsecureSkipVerify: true}
+		if _, err := concourse.Info(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+func TestClient_Middleware(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/teams", func(res http.ResponseWriter, req *http.Request) {
+		_, _ = io.WriteString(res, `[{"id":1,"name":"main"}]`)
+	})
+	mux.HandleFunc("/sky/issuer/token", writeToken)
+	server := httptest.NewUnstartedServer(mux)
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	server.StartTLS()
+	defer server.Close()
+
+	t.Run("wraps configured transport", func(t *testing.T) {
+		var (
+			mu             sync.Mutex
+			authorizations []string
+		)
+		concourse := glide.Client{
+			URL:                server.URL,
+			InsecureSkipVerify: true,
+			Middleware: func(next http.RoundTripper) http.RoundTripper {
+				return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					if req.URL.Path == "/api/v1/teams" {
+						mu.Lock()
+						authorizations = append(authorizations, req.Header.Get("Authorization"))
+						mu.Unlock()
+					}
+					return next.RoundTrip(req)
+				})
+			},
+		}
+		if _, err := concourse.Teams(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		if len(authorizations) != 1 || authorizations[0] != "Bearer fake-token" {
+			t.Errorf("unexpected authorization headers: %q", authorizations)
+		}
+	})
+	t.Run("custom transport", func(t *testing.T) {
+		concourse := glide.Client{
+			URL:                server.URL,
+			InsecureSkipVerify: true,
+		}
+		concourse.Client.Transport = roundTripperFunc(http.DefaultTransport.RoundTrip)
+		_, err := concourse.Teams(context.Background())
+		if err == nil || !strings.Contains(err.Error(), "Middleware") {
+			t.Errorf("expected the TLS fields to be rejected got: %v", err)
+		}
+		if _, err := concourse.Info(context.Background()); err == nil {
+			t.Error("expected unauthenticated requests to fail too")
+		}
+	})
+}
+
+func TestClient_RootCAs(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/teams", func(res http.ResponseWriter, req *http.Request) {
+		_, _ = io.WriteString(res, `[{"id":1,"name":"main"}]`)
+	})
+	mux.HandleFunc("/sky/issuer/token", writeToken)
+	server := httptest.NewTLSServer(mux)
+	defer server.Close()
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+
+	concourse := glide.Client{URL: server.URL, RootCAs: pool}
+	if _, err := concourse.Teams(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_Proxy(t *testing.T) {
+	var proxiedHosts []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		proxiedHosts = append(proxiedHosts, req.Host)
+		switch req.URL.Path {
+		case "/sky/issuer/token":
+			writeToken(res, req)
+		case "/api/v1/teams":
+			_, _ = io.WriteString(res, `[{"id":1,"name":"main"}]`)
+		default:
+			res.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer proxy.Close()
+	proxyURL, err := url.Parse(proxy.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	concourse := glide.Client{
+		URL:   "http://concourse.example.com",
+		Proxy: http.ProxyURL(proxyURL),
+		Client: http.Client{
+			Transport: &http.Transport{},
+		},
+	}
+	if _, err := concourse.Teams(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(proxiedHosts) != "[concourse.example.com concourse.example.com]" {
+		t.Errorf("unexpected proxied hosts: %v", proxiedHosts)
+	}
+}
+
+func TestClient_Timeout(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/teams", func(res http.ResponseWriter, req *http.Request) {
+		<-req.Context().Done()
+	})
+	mux.HandleFunc("/sky/issuer/token", writeToken)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.Client{URL: server.URL, Timeout: 10 * time.Millisecond}
+
+	_, err := concourse.Teams(context.Background())
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected a deadline exceeded error got: %v", err)
+	}
+}
+
+func TestClient_Timeout_unauthenticated(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/info", func(res http.ResponseWriter, req *http.Request) {
+		<-req.Context().Done()
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.NewClient(glide.WithURL(server.URL), glide.WithTimeout(10*time.Millisecond))
+
+	_, err := concourse.Info(context.Background())
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected a deadline exceeded error got: %v", err)
+	}
+}
+
+func TestClient_errors(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/teams/", func(res http.ResponseWriter, req *http.Request) {
+		code, err := strconv.Atoi(path.Base(path.Dir(req.URL.Path)))
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		res.Header().Set("Retry-After", "2")
+		res.WriteHeader(code)
+	})
+	mux.HandleFunc("/sky/issuer/token", writeToken)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.Client{URL: server.URL}
+
+	for _, tt := range []struct {
+		StatusCode int
+		Check      func(error) bool
+	}{
+		{StatusCode: http.StatusUnauthorized, Check: func(err error) bool {
+			var target *glide.UnauthorizedError
+			return errors.As(err, &target)
+		}},
+		{StatusCode: http.StatusForbidden, Check: func(err error) bool {
+			var target *glide.ForbiddenError
+			return errors.As(err, &target)
+		}},
+		{StatusCode: http.StatusNotFound, Check: func(err error) bool {
+			var target *glide.NotFoundError
+			return errors.As(err, &target)
+		}},
+		{StatusCode: http.StatusTooManyRequests, Check: func(err error) bool {
+			var target *glide.RateLimitedError
+			return errors.As(err, &target) && target.RetryAfter == 2*time.Second
+		}},
+	} {
+		t.Run(http.StatusText(tt.StatusCode), func(t *testing.T) {
+			_, err := concourse.Pipelines(context.Background(), strconv.Itoa(tt.StatusCode))
+			if !tt.Check(err) {
+				t.Errorf("unexpected error: %#v", err)
+			}
+		})
+	}
+}
+
+func TestClient_errorMessage(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/teams/main/pipelines/deploy", func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(http.StatusInternalServerError)
+		_, _ = io.WriteString(res, `{"error":"database is unavailable"}`)
+	})
+	mux.HandleFunc("/api/v1/teams/main/pipelines/test", func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(http.StatusInternalServerError)
+		_, _ = io.WriteString(res, `database is unavailable`)
+	})
+	mux.HandleFunc("/sky/issuer/token", writeToken)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.Client{URL: server.URL}
+
+	for _, pipeline := range []string{"deploy", "test"} {
+		_, err := concourse.Pipeline(context.Background(), "main", pipeline)
+		if err == nil || err.Error() != "http error: 500: database is unavailable" {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+}
+
+func TestClient_LastRequestID(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/teams/main/pipelines/deploy", func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("X-Concourse-Request-Id", "some-request-id")
+		res.WriteHeader(http.StatusInternalServerError)
+	})
+	mux.HandleFunc("/sky/issuer/token", writeToken)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.Client{URL: server.URL}
+
+	_, err := concourse.Pipeline(context.Background(), "main", "deploy")
+	if err == nil || !strings.Contains(err.Error(), "some-request-id") {
+		t.Errorf("expected the error to include the request id: %v", err)
+	}
+	if id := concourse.LastRequestID(); id != "some-request-id" {
+		t.Errorf("unexpected last request id: %q", id)
+	}
+}
+
+func TestClient_WaitForBuild(t *testing.T) {
+	var requests atomic.Int64
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/builds/7", func(res http.ResponseWriter, req *http.Request) {
+		status := glide.StatusStarted
+		if requests.Add(1) >= 3 {
+			status = glide.StatusSucceeded
+		}
+		_, _ = fmt.Fprintf(res, `{"id":7,"status":%q}`, status)
+	})
+	mux.HandleFunc("/sky/issuer/token", writeToken)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.Client{URL: server.URL}
+
+	build, err := concourse.WaitForBuild(context.Background(), 7, time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !build.IsSuccessful() {
+		t.Errorf("unexpected status: %q", build.Status)
+	}
+	if count := requests.Load(); count != 3 {
+		t.Errorf("expected 3 requests got %d", count)
+	}
+}
+
+func TestClient_AllPipelinesByTeam(t *testing.T) {
+	server, concourse := glidetest.NewServer(t)
+	for _, team := range []string{"main", "dev", "empty"} {
+		server.AddTeam(glide.Team{Name: team})
+	}
+	server.AddPipeline(glide.Pipeline{Name: "deploy", TeamName: "main"})
+	server.AddPipeline(glide.Pipeline{Name: "test", TeamName: "main"})
+	server.AddPipeline(glide.Pipeline{Name: "lint", TeamName: "dev"})
+
+	pipelines, err := concourse.AllPipelinesByTeam(context.Background(), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pipelines) != 3 || len(pipelines["main"]) != 2 || len(pipelines["dev"]) != 1 || len(pipelines["empty"]) != 0 {
+		t.Errorf("unexpected pipelines: %#v", pipelines)
+	}
+}
+
+func TestClient_JobStatuses(t *testing.T) {
+	server, concourse := glidetest.NewServer(t)
+	server.AddJob(glide.Job{Name: "finished", TeamName: "main", PipelineName: "deploy",
+		FinishedBuild:   glide.Build{ID: 2, Status: glide.StatusSucceeded},
+		TransitionBuild: glide.Build{ID: 1, Status: glide.StatusFailed},
+	})
+	server.AddJob(glide.Job{Name: "transition", TeamName: "main", PipelineName: "deploy",
+		TransitionBuild: glide.Build{ID: 3, Status: glide.StatusErrored},
+	})
+	server.AddJob(glide.Job{Name: "new", TeamName: "main", PipelineName: "deploy"})
+
+	statuses, err := concourse.JobStatuses(context.Background(), "main", "deploy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(statuses) != 3 || statuses["finished"].ID != 2 || statuses["transition"].ID != 3 || statuses["new"].ID != 0 {
+		t.Errorf("unexpected statuses: %#v", statuses)
+	}
+}
+
+func BenchmarkDecodeBuilds(b *testing.B) {
+	builds := make([]glide.Build, 20_000)
+	for i := range builds {
+		builds[i] = glide.Build{
+			ID:           i + 1,
+			Name:         strconv.Itoa(i + 1),
+			Status:       glide.StatusSucceeded,
+			StartTime:    1700000000,
+			EndTime:      1700000060,
+			TeamName:     "main",
+			PipelineName: "deploy",
+			JobName:      "unit",
+			URL:          "/api/v1/builds/" + strconv.Itoa(i+1),
+		}
+	}
+	body, err := json.Marshal(builds)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Logf("response size: %d bytes", len(body))
+
+	b.Run("ReadAll", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			buf, err := io.ReadAll(bytes.NewReader(body))
+			if err != nil {
+				b.Fatal(err)
+			}
+			var result []glide.Build
+			if err := json.Unmarshal(buf, &result); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("Decoder", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			var result []glide.Build
+			if err := json.NewDecoder(bytes.NewReader(body)).Decode(&result); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("Client", func(b *testing.B) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/api/v1/builds", func(res http.ResponseWriter, _ *http.Request) {
+			_, _ = res.Write(body)
+		})
+		server := httptest.NewServer(mux)
+		defer server.Close()
+		concourse := glide.NewClient(glide.WithURL(server.URL), glide.WithToken("fake-token"))
+		b.ReportAllocs()
+		b.ResetTimer()
+		for range b.N {
+			if _, _, err := concourse.Builds(context.Background(), glide.Page{}); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkClient_notFound(b *testing.B) {
+	body := []byte(`{"errors":["` + strings.Repeat("pipeline not found ", 100) + `"]}`)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/teams/main/pipelines", func(res http.ResponseWriter, _ *http.Request) {
+		res.WriteHeader(http.StatusNotFound)
+		_, _ = res.Write(body)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.NewClient(glide.WithURL(server.URL), glide.WithToken("fake-token"))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		_, err := concourse.Pipelines(context.Background(), "main")
+		if !errors.As(err, new(*glide.NotFoundError)) {
+			b.Fatalf("expected not found got: %v", err)
+		}
+	}
+}
+
+func TestClient_CheckResourceWebhook(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/teams/main/pipelines/deploy/resources/repo/check/webhook", func(res http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			t.Errorf("unexpected method: %s", req.Method)
+		}
+		if auth := req.Header.Get("Authorization"); auth != "" {
+			t.Errorf("unexpected authorization header: %q", auth)
+		}
+		if token := req.URL.Query().Get("webhook_token"); token != "s3cr3t" {
+			res.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		res.WriteHeader(http.StatusCreated)
+		_, _ = io.WriteString(res, `{"id":1,"status":"started"}`)
+	})
+	mux.HandleFunc("/sky/issuer/token", func(res http.ResponseWriter, req *http.Request) {
+		t.Error("webhook check must not request a token")
+		res.WriteHeader(http.StatusUnauthorized)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.Client{URL: server.URL}
+
+	if err := concourse.CheckResourceWebhook(context.Background(), "main", "deploy", "repo", "s3cr3t"); err != nil {
+		t.Fatal(err)
+	}
+	if err := concourse.CheckResourceWebhook(context.Background(), "main", "deploy", "repo", "wrong"); !errors.As(err, new(*glide.UnauthorizedError)) {
+		t.Errorf("expected unauthorized got: %v", err)
+	}
+}
+
+func TestClient_Wall(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		current = []byte(`{}`)
+	)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/wall", func(res http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch req.Method {
+		case http.MethodGet:
+			_, _ = res.Write(current)
+		case http.MethodPut:
+			current, _ = io.ReadAll(req.Body)
+		case http.MethodDelete:
+			current = []byte(`{}`)
+		default:
+			t.Errorf("unexpected method: %s", req.Method)
+		}
+	})
+	mux.HandleFunc("/sky/issuer/token", writeToken)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.Client{URL: server.URL}
+	ctx := context.Background()
+
+	expiresAt := time.Now().Add(time.Hour)
+	if err := concourse.SetWall(ctx, glide.Wall{Message: "maintenance", ExpiresAt: expiresAt}); err != nil {
+		t.Fatal(err)
+	}
+	wall, err := concourse.Wall(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wall.Message != "maintenance" {
+		t.Errorf("unexpected message: %q", wall.Message)
+	}
+	if d := wall.ExpiresAt.Sub(expiresAt); d > time.Second || d < -time.Second {
+		t.Errorf("unexpected expiration: %s", wall.ExpiresAt)
+	}
+
+	if err := concourse.SetWall(ctx, glide.Wall{Message: "late", ExpiresAt: time.Now().Add(-time.Minute)}); err == nil {
+		t.Error("expected an error for an expiration in the past")
+	}
+
+	if err := concourse.ClearWall(ctx); err != nil {
+		t.Fatal(err)
+	}
+	wall, err = concourse.Wall(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wall != (glide.Wall{}) {
+		t.Errorf("expected no wall got: %#v", wall)
+	}
+}
+
+func TestClient_DownloadCLI(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/cli", func(res http.ResponseWriter, req *http.Request) {
+		if auth := req.Header.Get("Authorization"); auth != "" {
+			t.Errorf("unexpected authorization header: %q", auth)
+		}
+		query := req.URL.Query()
+		if query.Get("platform") != "linux" || query.Get("arch") != "amd64" {
+			res.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		res.Header().Set("Content-Length", "3")
+		_, _ = io.WriteString(res, "fly")
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.Client{URL: server.URL}
+
+	var out bytes.Buffer
+	if err := concourse.DownloadCLI(context.Background(), "linux", "amd64", &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "fly" {
+		t.Errorf("unexpected download: %q", out.String())
+	}
+	if err := concourse.DownloadCLI(context.Background(), "plan9", "amd64", io.Discard); err == nil {
+		t.Error("expected an error for an unsupported platform")
+	}
+}
+
+func TestClient_DownloadCLI_truncated(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/cli", func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Length", "10")
+		_, _ = io.WriteString(res, "fly")
+	})
+	server := httptest.NewUnstartedServer(mux)
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	server.Start()
+	defer server.Close()
+	concourse := glide.Client{URL: server.URL}
+
+	if err := concourse.DownloadCLI(context.Background(), "linux", "amd64", io.Discard); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("expected unexpected EOF got: %v", err)
+	}
+}
+
+func TestClient_CredsInfo(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/info/creds", func(res http.ResponseWriter, req *http.Request) {
+		_, _ = io.WriteString(res, `{"vault":{"url":"https://vault.example.com","path_prefix":"/concourse"},"credhub":{"url":"https://credhub.example.com"}}`)
+	})
+	mux.HandleFunc("/sky/issuer/token", writeToken)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.Client{URL: server.URL}
+
+	info, err := concourse.CredsInfo(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if managers := info.Managers(); !slices.Equal(managers, []string{"credhub", "vault"}) {
+		t.Errorf("unexpected managers: %q", managers)
+	}
+	var vault struct {
+		URL string `json:"url"`
+	}
+	if err := json.Unmarshal(info["vault"], &vault); err != nil {
+		t.Fatal(err)
+	}
+	if vault.URL != "https://vault.example.com" {
+		t.Errorf("unexpected vault url: %q", vault.URL)
+	}
+}
+
+func TestClient_TeamJobs(t *testing.T) {
+	server, concourse := glidetest.NewServer(t)
+	server.AddPipeline(glide.Pipeline{Name: "deploy", TeamName: "main"})
+	server.AddPipeline(glide.Pipeline{Name: "test", TeamName: "main"})
+	server.AddJob(glide.Job{Name: "prod", TeamName: "main", PipelineName: "deploy"})
+	server.AddJob(glide.Job{Name: "unit", TeamName: "main", PipelineName: "test"})
+	server.AddJob(glide.Job{Name: "lint", TeamName: "other", PipelineName: "test"})
+
+	jobs, err := concourse.TeamJobs(context.Background(), "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs) != 2 || jobs[0].PipelineName != "deploy" || jobs[1].PipelineName != "test" {
+		t.Errorf("unexpected jobs: %#v", jobs)
+	}
+}
+
+func TestClient_AllJobs(t *testing.T) {
+	server, concourse := glidetest.NewServer(t)
+	server.AddJob(glide.Job{Name: "prod", TeamName: "main", PipelineName: "deploy"})
+	server.AddJob(glide.Job{Name: "lint", TeamName: "other", PipelineName: "test"})
+
+	jobs, err := concourse.AllJobs(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs) != 2 {
+		t.Errorf("unexpected jobs: %#v", jobs)
+	}
+
+	unauthorized := glide.NewClient(glide.WithURL(server.URL), glide.WithToken("wrong"))
+	if _, err := unauthorized.AllJobs(context.Background()); !errors.As(err, new(*glide.UnauthorizedError)) {
+		t.Errorf("expected unauthorized got: %v", err)
+	}
+}
+
+func TestClient_AllResources(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/resources", func(res http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			t.Errorf("unexpected method: %s", req.Method)
+		}
+		_, _ = io.WriteString(res, `[{"name":"repo","type":"git","team_name":"main","pipeline_name":"deploy"},{"name":"image","type":"registry-image","team_name":"other","pipeline_name":"build"}]`)
+	})
+	mux.HandleFunc("/sky/issuer/token", writeToken)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.Client{URL: server.URL}
+
+	resources, err := concourse.AllResources(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resources) != 2 || resources[0].Type != "git" || resources[1].TeamName != "other" || resources[1].PipelineName != "build" {
+		t.Errorf("unexpected resources: %#v", resources)
+	}
+}
+
+func TestClient_ClearTaskCache(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/teams/main/pipelines/deploy/jobs/unit/tasks/test/cache", func(res http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodDelete {
+			t.Errorf("unexpected method: %s", req.Method)
+		}
+		removed := 2
+		if req.URL.Query().Get("cachePath") == "node_modules" {
+			removed = 1
+		}
+		_, _ = fmt.Fprintf(res, `{"caches_removed":%d}`, removed)
+	})
+	mux.HandleFunc("/sky/issuer/token", writeToken)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.Client{URL: server.URL}
+
+	for cachePath, want := range map[string]int{"": 2, "node_modules": 1} {
+		removed, err := concourse.ClearTaskCache(context.Background(), "main", "deploy", "unit", "test", cachePath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if removed != want {
+			t.Errorf("cache path %q: expected %d removed got %d", cachePath, want, removed)
+		}
+	}
+}
+
+func TestClient_SetDefaultTeamAuth(t *testing.T) {
+	var received map[string]json.RawMessage
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/teams/main", func(res http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPut {
+			t.Errorf("unexpected method: %s", req.Method)
+		}
+		var team struct {
+			Auth map[string]json.RawMessage `json:"auth"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&team); err != nil {
+			t.Error(err)
+		}
+		received = team.Auth
+		_, _ = io.WriteString(res, `{"id":1,"name":"main"}`)
+	})
+	mux.HandleFunc("/sky/issuer/token", writeToken)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.Client{URL: server.URL}
+
+	err := concourse.SetDefaultTeamAuth(context.Background(), glide.TeamAuth{
+		Owner:            glide.TeamRole{Users: []string{"local:admin"}},
+		PipelineOperator: glide.TeamRole{Groups: []string{"github:org:ops"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(received) != 2 || received["owner"] == nil || string(received["pipeline-operator"]) != `{"users":null,"groups":["github:org:ops"]}` {
+		t.Errorf("unexpected auth: %s", received)
+	}
+
+	received = nil
+	if err := concourse.SetDefaultTeamAuth(context.Background(), glide.TeamAuth{
+		Member: glide.TeamRole{Users: []string{"local:dev"}},
+	}); err == nil {
+		t.Error("expected an error without an owner")
+	}
+	if received != nil {
+		t.Error("auth without an owner must not be sent")
+	}
+}
+
+func TestResourceVersion_VersionMap(t *testing.T) {
+	for _, tt := range []struct {
+		Version string
+		Want    map[string]string
+		WantErr bool
+	}{
+		{Version: `{"ref":"abc123"}`, Want: map[string]string{"ref": "abc123"}},
+		{Version: ``},
+		{Version: `null`},
+		{Version: `{"ref":{"nested":true}}`, WantErr: true},
+	} {
+		version := glide.ResourceVersion{Version: json.RawMessage(tt.Version)}
+		got, err := version.VersionMap()
+		if (err != nil) != tt.WantErr {
+			t.Errorf("%s: unexpected error: %v", tt.Version, err)
+		}
+		if !tt.WantErr && !maps.Equal(got, tt.Want) {
+			t.Errorf("%s: unexpected version: %v", tt.Version, got)
+		}
+	}
+}
+
+func TestClient_WaitForBuild_defaultInterval(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/builds/7", func(res http.ResponseWriter, req *http.Request) {
+		_, _ = io.WriteString(res, `{"id":7,"status":"started"}`)
+	})
+	mux.HandleFunc("/sky/issuer/token", writeToken)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.Client{URL: server.URL}
+
+	for _, interval := range []time.Duration{0, -time.Second} {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		build, err := concourse.WaitForBuild(ctx, 7, interval)
+		cancel()
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("interval %s: expected deadline exceeded got: %v", interval, err)
+		}
+		if build.Status != glide.StatusStarted {
+			t.Errorf("interval %s: unexpected status: %q", interval, build.Status)
+		}
+	}
+}
+
+func TestClient_Volumes(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/teams/main/volumes", func(res http.ResponseWriter, req *http.Request) {
+		_, _ = io.WriteString(res, `[
+			{"id":"a1","worker_name":"worker-0","type":"container","container_handle":"c1","path":"/tmp/build/get","parent_handle":"","pipeline_id":1,"pipeline_name":"deploy","job_name":"unit","step_name":"repo"},
+			{"id":"b2","worker_name":"worker-0","type":"resource","container_handle":"","path":"","parent_handle":"","resource_type":{"resource_type":{"resource_type":null,"base_resource_type":{"name":"registry-image","version":"1.0.0"},"version":{"digest":"sha256:abc"}},"base_resource_type":null,"version":{"ref":"b"}}},
+			{"id":"c3","worker_name":"worker-1","type":"resource-type","base_resource_type":{"name":"git","version":"1.14.0"}}
+		]`)
+	})
+	mux.HandleFunc("/sky/issuer/token", writeToken)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.Client{URL: server.URL}
+
+	volumes, err := concourse.Volumes(context.Background(), "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(volumes) != 3 {
+		t.Fatalf("unexpected volumes: %#v", volumes)
+	}
+	if volumes[0].StepName != "repo" || volumes[0].ResourceType != nil {
+		t.Errorf("unexpected container volume: %#v", volumes[0])
+	}
+	if cache := volumes[1].ResourceType; cache == nil || cache.ResourceType == nil || cache.ResourceType.BaseResourceType.Name != "registry-image" || string(cache.Version) != `{"ref":"b"}` {
+		t.Errorf("unexpected resource volume: %#v", volumes[1].ResourceType)
+	}
+	if base := volumes[2].BaseResourceType; base == nil || base.Name != "git" {
+		t.Errorf("unexpected resource type volume: %#v", volumes[2])
+	}
+}
+
+func TestClient_SetPipelineConfig(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/teams/main/pipelines/deploy/config", func(res http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPut {
+			t.Errorf("unexpected method: %s", req.Method)
+		}
+		if contentType := req.Header.Get("content-type"); contentType != "application/x-yaml" {
+			t.Errorf("unexpected content type: %q", contentType)
+		}
+		switch req.Header.Get(glide.ConfigVersionHeader) {
+		case "3":
+			res.WriteHeader(http.StatusOK)
+		case "2":
+			res.WriteHeader(http.StatusBadRequest)
+			_, _ = io.WriteString(res, `{"errors":["pipeline config version is stale"],"warnings":[{"type":"pipeline","message":"deprecated field"}]}`)
+		default:
+			res.WriteHeader(http.StatusBadRequest)
+			_, _ = io.WriteString(res, "malformed config")
+		}
+	})
+	mux.HandleFunc("/sky/issuer/token", writeToken)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.Client{URL: server.URL}
+	ctx := context.Background()
+	config := []byte("jobs: []\n")
+
+	t.Run("current version", func(t *testing.T) {
+		if err := concourse.SetPipelineConfig(ctx, "main", "deploy", config, "3"); err != nil {
+			t.Fatal(err)
+		}
+	})
+	t.Run("rejected", func(t *testing.T) {
+		err := concourse.SetPipelineConfig(ctx, "main", "deploy", config, "2")
+		var configErr *glide.ConfigError
+		if !errors.As(err, &configErr) {
+			t.Fatalf("expected a config error got: %v", err)
+		}
+		if configErr.Status() != http.StatusBadRequest {
+			t.Errorf("unexpected status: %d", configErr.Status())
+		}
+		if len(configErr.Errors) != 1 || configErr.Errors[0] != "pipeline config version is stale" {
+			t.Errorf("unexpected errors: %q", configErr.Errors)
+		}
+		if len(configErr.Warnings) != 1 || configErr.Warnings[0] != (glide.ConfigWarning{Type: "pipeline", Message: "deprecated field"}) {
+			t.Errorf("unexpected warnings: %#v", configErr.Warnings)
+		}
+	})
+	t.Run("not json", func(t *testing.T) {
+		err := concourse.SetPipelineConfig(ctx, "main", "deploy", config, "1")
+		if err == nil {
+			t.Fatal("expected an error")
+		}
+		if errors.As(err, new(*glide.ConfigError)) {
+			t.Errorf("unexpected config error: %v", err)
+		}
+		if !strings.Contains(err.Error(), "400") || !strings.Contains(err.Error(), "malformed config") {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestClient_ArchivePipeline(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/teams/main/pipelines/", func(res http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPut {
+			t.Errorf("unexpected method: %s", req.Method)
+		}
+		switch req.URL.Path {
+		case "/api/v1/teams/main/pipelines/paused/archive":
+			res.WriteHeader(http.StatusOK)
+		case "/api/v1/teams/main/pipelines/running/archive":
+			res.WriteHeader(http.StatusConflict)
+		default:
+			res.WriteHeader(http.StatusNotFound)
+		}
+	})
+	mux.HandleFunc("/sky/issuer/token", writeToken)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.Client{URL: server.URL}
+	ctx := context.Background()
+
+	if err := concourse.ArchivePipeline(ctx, "main", "paused"); err != nil {
+		t.Fatal(err)
+	}
+
+	err := concourse.ArchivePipeline(ctx, "main", "running")
+	if !errors.Is(err, glide.ErrPipelineNotPaused) {
+		t.Errorf("expected ErrPipelineNotPaused got: %v", err)
+	}
+	if !errors.As(err, new(*glide.ConflictError)) {
+		t.Errorf("expected a conflict error got: %v", err)
+	}
+
+	err = concourse.ArchivePipeline(ctx, "main", "missing")
+	if errors.Is(err, glide.ErrPipelineNotPaused) || !errors.As(err, new(*glide.NotFoundError)) {
+		t.Errorf("expected only a not found error got: %v", err)
+	}
+}
+
+func TestClient_PruneWorker(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/workers/", func(res http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPut {
+			t.Errorf("unexpected method: %s", req.Method)
+		}
+		switch req.URL.Path {
+		case "/api/v1/workers/stalled/prune":
+			res.WriteHeader(http.StatusOK)
+		case "/api/v1/workers/running/prune":
+			res.WriteHeader(http.StatusBadRequest)
+			_, _ = io.WriteString(res, `{"stderr":"cannot prune running worker"}`)
+		default:
+			res.WriteHeader(http.StatusNotFound)
+		}
+	})
+	mux.HandleFunc("/sky/issuer/token", writeToken)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.Client{URL: server.URL}
+	ctx := context.Background()
+
+	if err := concourse.PruneWorker(ctx, "stalled"); err != nil {
+		t.Fatal(err)
+	}
+
+	err := concourse.PruneWorker(ctx, "running")
+	if !errors.Is(err, glide.ErrWorkerRunning) {
+		t.Errorf("expected ErrWorkerRunning got: %v", err)
+	}
+	var status interface{ Status() int }
+	if !errors.As(err, &status) || status.Status() != http.StatusBadRequest {
+		t.Errorf("expected the http error to be wrapped got: %v", err)
+	}
+
+	err = concourse.PruneWorker(ctx, "missing")
+	if errors.Is(err, glide.ErrWorkerRunning) || !errors.As(err, new(*glide.NotFoundError)) {
+		t.Errorf("expected only a not found error got: %v", err)
+	}
+}
+
+func TestClient_Wall_anonymous(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/wall", func(res http.ResponseWriter, req *http.Request) {
+		if auth := req.Header.Get("Authorization"); auth != "" {
+			t.Errorf("unexpected authorization header: %q", auth)
+		}
+		_, _ = io.WriteString(res, `{"message":"maintenance"}`)
+	})
+	mux.HandleFunc("/sky/issuer/token", func(res http.ResponseWriter, req *http.Request) {
+		t.Error("wall must not request a token")
+		res.WriteHeader(http.StatusUnauthorized)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.Client{URL: server.URL}
+
+	wall, err := concourse.Wall(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wall.Message != "maintenance" || !wall.ExpiresAt.IsZero() {
+		t.Errorf("unexpected wall: %#v", wall)
+	}
+}
+
+func TestClient_Limiter(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/info", func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("X-Concourse-Request-Id", "info-request")
+		_, _ = io.WriteString(res, `{"version":"7.11.2"}`)
+	})
+	mux.HandleFunc("/api/v1/teams", func(res http.ResponseWriter, req *http.Request) {
+		_, _ = io.WriteString(res, `[]`)
+	})
+	mux.HandleFunc("/sky/issuer/token", writeToken)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	t.Run("waits", func(t *testing.T) {
+		const interval = 30 * time.Millisecond
+		concourse := glide.Client{URL: server.URL, Limiter: rate.NewLimiter(rate.Every(interval), 1)}
+		start := time.Now()
+		if _, err := concourse.Info(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := concourse.Teams(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := concourse.Info(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		if elapsed := time.Since(start); elapsed < 2*interval {
+			t.Errorf("expected requests to wait on the limiter, finished in %s", elapsed)
+		}
+		if id := concourse.LastRequestID(); id != "info-request" {
+			t.Errorf("unexpected request id: %q", id)
+		}
+	})
+	t.Run("canceled", func(t *testing.T) {
+		concourse := glide.Client{URL: server.URL, Limiter: rate.NewLimiter(rate.Every(time.Hour), 1)}
+		if _, err := concourse.Info(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(10*time.Millisecond, cancel)
+		if _, err := concourse.Info(ctx); !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected the limiter wait to be canceled got: %v", err)
+		}
+	})
+}
+
+func TestHTTPError(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/teams", func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Retry-After", "30")
+		res.Header().Set("X-Concourse-Request-Id", "some-request-id")
+		res.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = io.WriteString(res, `{"error":"down for maintenance"}`)
+	})
+	mux.HandleFunc("/sky/issuer/token", writeToken)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.Client{URL: server.URL}
+
+	_, err := concourse.Teams(context.Background())
+	var httpErr *glide.HTTPError
+	if !errors.As(err, &httpErr) {
+		t.Fatalf("expected an http error got: %v", err)
+	}
+	if httpErr.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("unexpected status: %d", httpErr.StatusCode)
+	}
+	if retryAfter := httpErr.Header.Get("Retry-After"); retryAfter != "30" {
+		t.Errorf("unexpected Retry-After: %q", retryAfter)
+	}
+	if httpErr.RequestID != "some-request-id" {
+		t.Errorf("unexpected request id: %q", httpErr.RequestID)
+	}
+	if httpErr.Message() != "down for maintenance" || string(httpErr.Body) != `{"error":"down for maintenance"}` {
+		t.Errorf("unexpected body: %q", httpErr.Body)
+	}
+
+	// typed errors unwrap to the HTTPError
+	notFound := &glide.NotFoundError{HTTPError: glide.HTTPError{StatusCode: http.StatusNotFound}}
+	if !errors.As(error(notFound), &httpErr) || httpErr.StatusCode != http.StatusNotFound {
+		t.Errorf("expected typed errors to unwrap to HTTPError")
+	}
+}
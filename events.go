@@ -0,0 +1,306 @@
+package glide
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/vito/go-sse/sse"
+)
+
+// BuildEvent is received from the build event stream. When the stream fails
+// before the ATC ends it, the last event received has a non-nil Err and no
+// other fields set.
+type BuildEvent struct {
+	Data  BuildEventData `json:"data"`
+	Event string         `json:"event"`
+
+	// ID is the server-sent event ID. The ATC uses it as a cursor for the
+	// Last-Event-ID header.
+	ID string `json:"-"`
+
+	Err error `json:"-"`
+}
+
+type BuildEventData struct {
+	Payload string          `json:"payload"`
+	Time    int64           `json:"time"`
+	Origin  json.RawMessage `json:"origin"`
+	Message string          `json:"message"`
+	Status  string          `json:"status,omitempty"`
+}
+
+// Event kinds sent in BuildEvent.Event.
+const (
+	EventLog                = "log"
+	EventStatus             = "status"
+	EventError              = "error"
+	EventSelectedWorker     = "selected-worker"
+	EventInitialize         = "initialize"
+	EventStart              = "start"
+	EventFinish             = "finish"
+	EventInitializeTask     = "initialize-task"
+	EventStartTask          = "start-task"
+	EventFinishTask         = "finish-task"
+	EventInitializeGet      = "initialize-get"
+	EventStartGet           = "start-get"
+	EventFinishGet          = "finish-get"
+	EventInitializePut      = "initialize-put"
+	EventStartPut           = "start-put"
+	EventFinishPut          = "finish-put"
+	EventSetPipelineChanged = "set-pipeline-changed"
+)
+
+// Status returns the build status sent by a status event. It returns false
+// for other events.
+func (event BuildEvent) Status() (string, bool) {
+	if event.Event != EventStatus {
+		return "", false
+	}
+	return event.Data.Status, true
+}
+
+// Origin identifies the step of the build plan that emitted an event.
+type Origin struct {
+	// ID is the ID of the plan step. It matches an "id" in the build plan.
+	ID string `json:"id"`
+
+	// Source is OriginSourceStdout or OriginSourceStderr for log events.
+	Source string `json:"source,omitempty"`
+}
+
+const (
+	OriginSourceStdout = "stdout"
+	OriginSourceStderr = "stderr"
+)
+
+// ParseOrigin decodes Origin. Events without an origin return the zero
+// Origin.
+func (data BuildEventData) ParseOrigin() (Origin, error) {
+	var origin Origin
+	if len(data.Origin) == 0 {
+		return origin, nil
+	}
+	return origin, json.Unmarshal(data.Origin, &origin)
+}
+
+// BuildEvents streams the events of a build. The channel is closed when the
+// ATC ends the stream, when the stream fails, or when ctx is canceled. When
+// Client.BuildEventsMaxReconnects is positive, a failed stream is reopened
+// after the last received event.
+func (client *Client) BuildEvents(ctx context.Context, buildID int) (<-chan BuildEvent, error) {
+	return client.BuildEventsFiltered(ctx, buildID)
+}
+
+// BuildEventsFiltered is like BuildEvents but only sends events with one of
+// the kinds. Events with other kinds are dropped before they are fully
+// decoded. Stream errors are always sent. When no kinds are passed every
+// event is sent.
+func (client *Client) BuildEventsFiltered(ctx context.Context, buildID int, kinds ...string) (<-chan BuildEvent, error) {
+	rc, err := client.openBuildEvents(ctx, buildID, "")
+	if err != nil {
+		return nil, err
+	}
+	c := make(chan BuildEvent)
+	go client.sendBuildEvents(ctx, buildID, kinds, c, rc)
+	return c, nil
+}
+
+func (client *Client) openBuildEvents(ctx context.Context, buildID int, lastEventID string) (*sse.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, client.APIPath("builds", strconv.Itoa(buildID), "events"), nil)
+	if err != nil {
+		return nil, err
+	}
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	res, err := client.do(req, 0, &client.Client)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		defer closeAndIgnoreErr(res.Body)
+		return nil, readHTTPError(res)
+	}
+	return sse.NewReadCloser(res.Body), nil
+}
+
+func (client *Client) sendBuildEvents(ctx context.Context, buildID int, kinds []string, c chan<- BuildEvent, rc *sse.ReadCloser) {
+	defer close(c)
+
+	// closing the stream unblocks rc.Next when ctx is canceled while the ATC
+	// is not sending events
+	var mu sync.Mutex
+	closeStream := func() {
+		mu.Lock()
+		defer mu.Unlock()
+		closeAndIgnoreErr(rc)
+	}
+	stop := context.AfterFunc(ctx, closeStream)
+	defer func() {
+		stop()
+		closeStream()
+	}()
+
+	send := func(event BuildEvent) bool {
+		select {
+		case c <- event:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	var (
+		lastEventID string
+		reconnects  int
+	)
+	for {
+		if err := ctx.Err(); err != nil {
+			return
+		}
+		event, err := rc.Next()
+		for err != nil && ctx.Err() == nil && reconnects < client.BuildEventsMaxReconnects {
+			reconnects++
+			closeStream()
+			// back off so an ATC that is restarting has time to come back
+			timer := time.NewTimer(client.RetryPolicy.backoff(reconnects))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+			var reopened *sse.ReadCloser
+			if reopened, err = client.openBuildEvents(ctx, buildID, lastEventID); err == nil {
+				mu.Lock()
+				rc = reopened
+				mu.Unlock()
+				event, err = rc.Next()
+			}
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				err = io.ErrUnexpectedEOF
+			}
+			if ctx.Err() == nil {
+				send(BuildEvent{Err: err})
+			}
+			return
+		}
+		if event.Name == "end" {
+			return
+		}
+		lastEventID = event.ID
+		if len(kinds) > 0 {
+			var kind struct {
+				Event string `json:"event"`
+			}
+			if err := json.Unmarshal(event.Data, &kind); err != nil || !slices.Contains(kinds, kind.Event) {
+				continue
+			}
+		}
+		var message BuildEvent
+		if err := json.Unmarshal(event.Data, &message); err != nil {
+			continue
+		}
+		message.ID = event.ID
+		if !send(message) {
+			return
+		}
+	}
+}
+
+// AllBuildEvents reads the build event stream until it ends. The events
+// received before a stream error are returned with the error.
+func (client *Client) AllBuildEvents(ctx context.Context, buildID int) ([]BuildEvent, error) {
+	events, err := client.BuildEvents(ctx, buildID)
+	if err != nil {
+		return nil, err
+	}
+	var result []BuildEvent
+	for event := range events {
+		if event.Err != nil {
+			return result, event.Err
+		}
+		result = append(result, event)
+	}
+	return result, ctx.Err()
+}
+
+// BuildLog returns the payloads of the build log events as a stream of
+// bytes. Reading returns io.EOF when the build event stream ends and the
+// stream error if it fails. Close stops the stream.
+func (client *Client) BuildLog(ctx context.Context, buildID int) (io.ReadCloser, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	events, err := client.BuildEvents(ctx, buildID)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	pr, pw := io.Pipe()
+	go func() {
+		defer cancel()
+		for event := range events {
+			if event.Err != nil {
+				_ = pw.CloseWithError(event.Err)
+				return
+			}
+			if event.Event != EventLog {
+				continue
+			}
+			if _, err := io.WriteString(pw, event.Data.Payload); err != nil {
+				return
+			}
+		}
+		_ = pw.CloseWithError(ctx.Err())
+	}()
+	return &buildLog{PipeReader: pr, cancel: cancel}, nil
+}
+
+type buildLog struct {
+	*io.PipeReader
+	cancel context.CancelFunc
+}
+
+func (log *buildLog) Close() error {
+	log.cancel()
+	return log.PipeReader.Close()
+}
+
+// ErrBuildNotSucceeded is returned by RunJob when the build finishes with a
+// status other than StatusSucceeded.
+var ErrBuildNotSucceeded = errors.New("build did not succeed")
+
+// RunJob triggers a build of the job, copies the build log to out, and
+// returns the finished build. The build is returned with an error wrapping
+// ErrBuildNotSucceeded when it fails, errors, or is aborted.
+func (client *Client) RunJob(ctx context.Context, team, pipeline, job string, out io.Writer) (Build, error) {
+	build, err := client.CreateJobBuild(ctx, team, pipeline, job)
+	if err != nil {
+		return build, err
+	}
+	log, err := client.BuildLog(ctx, build.ID)
+	if err != nil {
+		return build, err
+	}
+	defer closeAndIgnoreErr(log)
+	if _, err := io.Copy(out, log); err != nil {
+		return build, err
+	}
+	build, err = client.WaitForBuild(ctx, build.ID, time.Second)
+	if err != nil {
+		return build, err
+	}
+	if !build.IsSuccessful() {
+		return build, fmt.Errorf("%w: build %s of %s/%s %s", ErrBuildNotSucceeded, build.Name, pipeline, job, build.Status)
+	}
+	return build, nil
+}
@@ -0,0 +1,294 @@
+package glide_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/crhntr/glide"
+)
+
+func writeBuildEvents(res http.ResponseWriter, end bool, events ...string) {
+	res.Header().Set("content-type", "text/event-stream")
+	for i, event := range events {
+		_, _ = io.WriteString(res, "id: "+strconv.Itoa(i)+"\nevent: event\ndata: "+event+"\n\n")
+	}
+	if end {
+		_, _ = io.WriteString(res, "event: end\ndata\n\n")
+	}
+}
+
+func TestClient_BuildEvents(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/builds/1/events", func(res http.ResponseWriter, req *http.Request) {
+		writeBuildEvents(res, true, `{"event":"log","data":{"payload":"hello\n"}}`)
+	})
+	mux.HandleFunc("/api/v1/builds/2/events", func(res http.ResponseWriter, req *http.Request) {
+		writeBuildEvents(res, false, `{"event":"log","data":{"payload":"hello\n"}}`)
+	})
+	mux.HandleFunc("/sky/issuer/token", writeToken)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.Client{URL: server.URL}
+
+	t.Run("end", func(t *testing.T) {
+		events, err := concourse.BuildEvents(context.Background(), 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var received []glide.BuildEvent
+		for event := range events {
+			received = append(received, event)
+		}
+		if len(received) != 1 || received[0].Data.Payload != "hello\n" || received[0].ID != "0" || received[0].Err != nil {
+			t.Errorf("unexpected events: %#v", received)
+		}
+	})
+	t.Run("disconnected", func(t *testing.T) {
+		events, err := concourse.BuildEvents(context.Background(), 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var received []glide.BuildEvent
+		for event := range events {
+			received = append(received, event)
+		}
+		if len(received) != 2 || !errors.Is(received[1].Err, io.ErrUnexpectedEOF) {
+			t.Errorf("unexpected events: %#v", received)
+		}
+	})
+}
+
+func TestClient_BuildEvents_reconnect(t *testing.T) {
+	var (
+		lastEventIDs []string
+		requestTimes []time.Time
+	)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/builds/1/events", func(res http.ResponseWriter, req *http.Request) {
+		lastEventID := req.Header.Get("Last-Event-ID")
+		lastEventIDs = append(lastEventIDs, lastEventID)
+		requestTimes = append(requestTimes, time.Now())
+		res.Header().Set("content-type", "text/event-stream")
+		switch lastEventID {
+		case "":
+			_, _ = io.WriteString(res, "id: 0\nevent: event\ndata: {\"event\":\"log\",\"data\":{\"payload\":\"one\"}}\n\n")
+		case "0":
+			_, _ = io.WriteString(res, "id: 1\nevent: event\ndata: {\"event\":\"log\",\"data\":{\"payload\":\"two\"}}\n\n")
+			_, _ = io.WriteString(res, "event: end\ndata\n\n")
+		}
+	})
+	mux.HandleFunc("/sky/issuer/token", writeToken)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	const delay = 20 * time.Millisecond
+	concourse := glide.Client{URL: server.URL, BuildEventsMaxReconnects: 1, RetryPolicy: glide.RetryPolicy{BaseDelay: delay}}
+
+	events, err := concourse.BuildEvents(context.Background(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var payloads []string
+	for event := range events {
+		if event.Err != nil {
+			t.Fatal(event.Err)
+		}
+		payloads = append(payloads, event.Data.Payload)
+	}
+	if fmt.Sprint(payloads) != "[one two]" {
+		t.Errorf("unexpected payloads: %v", payloads)
+	}
+	if fmt.Sprintf("%q", lastEventIDs) != `["" "0"]` {
+		t.Errorf("unexpected Last-Event-ID headers: %q", lastEventIDs)
+	}
+	if gap := requestTimes[1].Sub(requestTimes[0]); gap < delay {
+		t.Errorf("expected the stream to be reopened after %s got %s", delay, gap)
+	}
+}
+
+func TestClient_BuildEvents_reconnectCanceled(t *testing.T) {
+	var requests atomic.Int64
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/builds/1/events", func(res http.ResponseWriter, req *http.Request) {
+		requests.Add(1)
+		writeBuildEvents(res, false, `{"event":"log","data":{"payload":"one"}}`)
+	})
+	mux.HandleFunc("/sky/issuer/token", writeToken)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.Client{URL: server.URL, BuildEventsMaxReconnects: 5, RetryPolicy: glide.RetryPolicy{BaseDelay: time.Hour}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, err := concourse.BuildEvents(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-events
+	cancel()
+	for event := range events {
+		t.Errorf("unexpected event after cancel: %#v", event)
+	}
+	if count := requests.Load(); count != 1 {
+		t.Errorf("expected the stream not to be reopened got %d requests", count)
+	}
+}
+
+func TestClient_BuildLog(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/builds/1/events", func(res http.ResponseWriter, req *http.Request) {
+		writeBuildEvents(res, true,
+			`{"event":"log","data":{"payload":"hello\n"}}`,
+			`{"event":"status","data":{"status":"started"}}`,
+			`{"event":"log","data":{"payload":"world\n"}}`,
+		)
+	})
+	mux.HandleFunc("/sky/issuer/token", writeToken)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.Client{URL: server.URL}
+
+	log, err := concourse.BuildLog(context.Background(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeAndCheckErr(t, log)
+	buf, err := io.ReadAll(log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(buf) != "hello\nworld\n" {
+		t.Errorf("unexpected log: %q", buf)
+	}
+}
+
+func closeAndCheckErr(t *testing.T, c io.Closer) {
+	t.Helper()
+	if err := c.Close(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestClient_BuildEventsFiltered(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/builds/1/events", func(res http.ResponseWriter, req *http.Request) {
+		writeBuildEvents(res, true,
+			`{"event":"log","data":{"payload":"hello\n"}}`,
+			`{"event":"error","data":{"message":"oops"}}`,
+			`{"event":"log","data":{"payload":"world\n"}}`,
+			`{"event":"status","data":{"status":"errored"}}`,
+		)
+	})
+	mux.HandleFunc("/sky/issuer/token", writeToken)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.Client{URL: server.URL}
+
+	events, err := concourse.BuildEventsFiltered(context.Background(), 1, glide.EventError, glide.EventStatus)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var kinds []string
+	for event := range events {
+		kinds = append(kinds, event.Event)
+	}
+	if fmt.Sprint(kinds) != "[error status]" {
+		t.Errorf("unexpected event kinds: %v", kinds)
+	}
+}
+
+func TestClient_BuildEvents_cancel(t *testing.T) {
+	done := make(chan struct{})
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/builds/1/events", func(res http.ResponseWriter, req *http.Request) {
+		writeBuildEvents(res, false, `{"event":"log","data":{"payload":"hello\n"}}`)
+		res.(http.Flusher).Flush()
+		select {
+		case <-req.Context().Done():
+		case <-done:
+		}
+	})
+	mux.HandleFunc("/sky/issuer/token", writeToken)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	defer close(done)
+	concourse := glide.Client{URL: server.URL}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events, err := concourse.BuildEvents(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event := <-events; event.Data.Payload != "hello\n" {
+		t.Fatalf("unexpected event: %#v", event)
+	}
+	cancel()
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case _, open := <-events:
+			if !open {
+				return
+			}
+		case <-timeout:
+			t.Fatal("expected the events channel to close after the context is canceled")
+		}
+	}
+}
+
+func TestClient_RunJob(t *testing.T) {
+	for _, tt := range []struct {
+		Status  string
+		WantErr bool
+	}{
+		{Status: glide.StatusSucceeded},
+		{Status: glide.StatusFailed, WantErr: true},
+	} {
+		t.Run(tt.Status, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("/api/v1/teams/main/pipelines/p/jobs/j/builds", func(res http.ResponseWriter, req *http.Request) {
+				if req.Method != http.MethodPost {
+					res.WriteHeader(http.StatusMethodNotAllowed)
+					return
+				}
+				_, _ = io.WriteString(res, `{"id":3,"name":"1","status":"pending"}`)
+			})
+			mux.HandleFunc("/api/v1/builds/3/events", func(res http.ResponseWriter, req *http.Request) {
+				writeBuildEvents(res, true,
+					`{"event":"log","data":{"payload":"hello\n"}}`,
+					`{"event":"status","data":{"status":"`+tt.Status+`"}}`,
+				)
+			})
+			mux.HandleFunc("/api/v1/builds/3", func(res http.ResponseWriter, req *http.Request) {
+				_, _ = fmt.Fprintf(res, `{"id":3,"name":"1","status":%q}`, tt.Status)
+			})
+			mux.HandleFunc("/sky/issuer/token", writeToken)
+			server := httptest.NewServer(mux)
+			defer server.Close()
+			concourse := glide.Client{URL: server.URL}
+
+			var out strings.Builder
+			build, err := concourse.RunJob(context.Background(), "main", "p", "j", &out)
+			if tt.WantErr != errors.Is(err, glide.ErrBuildNotSucceeded) {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if !tt.WantErr && err != nil {
+				t.Fatal(err)
+			}
+			if build.Status != tt.Status {
+				t.Errorf("unexpected status: %q", build.Status)
+			}
+			if got := out.String(); got != "hello\n" {
+				t.Errorf("unexpected log: %q", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package glide
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+type flyRC struct {
+	Targets map[string]flyTarget `yaml:"targets"`
+}
+
+type flyTarget struct {
+	API   string `yaml:"api"`
+	Token struct {
+		Type  string `yaml:"type"`
+		Value string `yaml:"value"`
+	} `yaml:"token"`
+}
+
+// LoadTarget creates a Client for a target saved by "fly login". The targets
+// are read from the file named by FLY_RC or from ~/.flyrc.
+func LoadTarget(name string) (*Client, error) {
+	rcPath, err := flyRCPath()
+	if err != nil {
+		return nil, err
+	}
+	buf, err := os.ReadFile(rcPath)
+	if err != nil {
+		return nil, err
+	}
+	var rc flyRC
+	if err := yaml.Unmarshal(buf, &rc); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", rcPath, err)
+	}
+	target, ok := rc.Targets[name]
+	if !ok {
+		return nil, fmt.Errorf("target %q not found in %s", name, rcPath)
+	}
+	return &Client{
+		URL:         target.API,
+		BearerToken: target.Token.Value,
+	}, nil
+}
+
+func flyRCPath() (string, error) {
+	if value, isSet := os.LookupEnv("FLY_RC"); isSet && value != "" {
+		return value, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".flyrc"), nil
+}
@@ -0,0 +1,39 @@
+package glide_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/crhntr/glide"
+)
+
+func TestLoadTarget(t *testing.T) {
+	rcPath := filepath.Join(t.TempDir(), "flyrc")
+	if err := os.WriteFile(rcPath, []byte(`targets:
+  ci:
+    api: https://ci.example.com
+    team: main
+    token:
+      type: bearer
+      value: some-token
+`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("FLY_RC", rcPath)
+
+	t.Run("found", func(t *testing.T) {
+		client, err := glide.LoadTarget("ci")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if client.URL != "https://ci.example.com" || client.BearerToken != "some-token" {
+			t.Errorf("unexpected client: %q %q", client.URL, client.BearerToken)
+		}
+	})
+	t.Run("missing", func(t *testing.T) {
+		if _, err := glide.LoadTarget("missing"); err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+}
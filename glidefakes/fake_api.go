@@ -0,0 +1,3368 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package glidefakes
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+
+	"github.com/crhntr/glide"
+)
+
+type FakeAPI struct {
+	AllBuildEventsStub        func(context.Context, int) ([]glide.BuildEvent, error)
+	allBuildEventsMutex       sync.RWMutex
+	allBuildEventsArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	allBuildEventsReturns struct {
+		result1 []glide.BuildEvent
+		result2 error
+	}
+	allBuildEventsReturnsOnCall map[int]struct {
+		result1 []glide.BuildEvent
+		result2 error
+	}
+	AllJobsStub        func(context.Context) ([]glide.Job, error)
+	allJobsMutex       sync.RWMutex
+	allJobsArgsForCall []struct {
+		arg1 context.Context
+	}
+	allJobsReturns struct {
+		result1 []glide.Job
+		result2 error
+	}
+	allJobsReturnsOnCall map[int]struct {
+		result1 []glide.Job
+		result2 error
+	}
+	AllPipelinesStub        func(context.Context) ([]glide.Pipeline, error)
+	allPipelinesMutex       sync.RWMutex
+	allPipelinesArgsForCall []struct {
+		arg1 context.Context
+	}
+	allPipelinesReturns struct {
+		result1 []glide.Pipeline
+		result2 error
+	}
+	allPipelinesReturnsOnCall map[int]struct {
+		result1 []glide.Pipeline
+		result2 error
+	}
+	AllPipelinesByTeamStub        func(context.Context, int) (map[string][]glide.Pipeline, error)
+	allPipelinesByTeamMutex       sync.RWMutex
+	allPipelinesByTeamArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	allPipelinesByTeamReturns struct {
+		result1 map[string][]glide.Pipeline
+		result2 error
+	}
+	allPipelinesByTeamReturnsOnCall map[int]struct {
+		result1 map[string][]glide.Pipeline
+		result2 error
+	}
+	AllResourcesStub        func(context.Context) ([]glide.Resource, error)
+	allResourcesMutex       sync.RWMutex
+	allResourcesArgsForCall []struct {
+		arg1 context.Context
+	}
+	allResourcesReturns struct {
+		result1 []glide.Resource
+		result2 error
+	}
+	allResourcesReturnsOnCall map[int]struct {
+		result1 []glide.Resource
+		result2 error
+	}
+	BuildStub        func(context.Context, int) (glide.Build, error)
+	buildMutex       sync.RWMutex
+	buildArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	buildReturns struct {
+		result1 glide.Build
+		result2 error
+	}
+	buildReturnsOnCall map[int]struct {
+		result1 glide.Build
+		result2 error
+	}
+	BuildEventsStub        func(context.Context, int) (<-chan glide.BuildEvent, error)
+	buildEventsMutex       sync.RWMutex
+	buildEventsArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	buildEventsReturns struct {
+		result1 <-chan glide.BuildEvent
+		result2 error
+	}
+	buildEventsReturnsOnCall map[int]struct {
+		result1 <-chan glide.BuildEvent
+		result2 error
+	}
+	BuildLogStub        func(context.Context, int) (io.ReadCloser, error)
+	buildLogMutex       sync.RWMutex
+	buildLogArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	buildLogReturns struct {
+		result1 io.ReadCloser
+		result2 error
+	}
+	buildLogReturnsOnCall map[int]struct {
+		result1 io.ReadCloser
+		result2 error
+	}
+	BuildPlanStub        func(context.Context, int) (json.RawMessage, string, error)
+	buildPlanMutex       sync.RWMutex
+	buildPlanArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	buildPlanReturns struct {
+		result1 json.RawMessage
+		result2 string
+		result3 error
+	}
+	buildPlanReturnsOnCall map[int]struct {
+		result1 json.RawMessage
+		result2 string
+		result3 error
+	}
+	BuildPreparationStub        func(context.Context, int) (glide.BuildPreparation, error)
+	buildPreparationMutex       sync.RWMutex
+	buildPreparationArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	buildPreparationReturns struct {
+		result1 glide.BuildPreparation
+		result2 error
+	}
+	buildPreparationReturnsOnCall map[int]struct {
+		result1 glide.BuildPreparation
+		result2 error
+	}
+	BuildResourcesStub        func(context.Context, int) (glide.BuildResources, error)
+	buildResourcesMutex       sync.RWMutex
+	buildResourcesArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	buildResourcesReturns struct {
+		result1 glide.BuildResources
+		result2 error
+	}
+	buildResourcesReturnsOnCall map[int]struct {
+		result1 glide.BuildResources
+		result2 error
+	}
+	BuildsStub        func(context.Context, glide.Page) ([]glide.Build, glide.Pagination, error)
+	buildsMutex       sync.RWMutex
+	buildsArgsForCall []struct {
+		arg1 context.Context
+		arg2 glide.Page
+	}
+	buildsReturns struct {
+		result1 []glide.Build
+		result2 glide.Pagination
+		result3 error
+	}
+	buildsReturnsOnCall map[int]struct {
+		result1 []glide.Build
+		result2 glide.Pagination
+		result3 error
+	}
+	BuildsWithResourceVersionAsOutputStub        func(context.Context, string, string, string, int) ([]glide.Build, error)
+	buildsWithResourceVersionAsOutputMutex       sync.RWMutex
+	buildsWithResourceVersionAsOutputArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 int
+	}
+	buildsWithResourceVersionAsOutputReturns struct {
+		result1 []glide.Build
+		result2 error
+	}
+	buildsWithResourceVersionAsOutputReturnsOnCall map[int]struct {
+		result1 []glide.Build
+		result2 error
+	}
+	ContainerStub        func(context.Context, string, string) (glide.Container, error)
+	containerMutex       sync.RWMutex
+	containerArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	containerReturns struct {
+		result1 glide.Container
+		result2 error
+	}
+	containerReturnsOnCall map[int]struct {
+		result1 glide.Container
+		result2 error
+	}
+	ContainersStub        func(context.Context, string, glide.ContainerFilter) ([]glide.Container, error)
+	containersMutex       sync.RWMutex
+	containersArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 glide.ContainerFilter
+	}
+	containersReturns struct {
+		result1 []glide.Container
+		result2 error
+	}
+	containersReturnsOnCall map[int]struct {
+		result1 []glide.Container
+		result2 error
+	}
+	CredsInfoStub        func(context.Context) (glide.CredsInfo, error)
+	credsInfoMutex       sync.RWMutex
+	credsInfoArgsForCall []struct {
+		arg1 context.Context
+	}
+	credsInfoReturns struct {
+		result1 glide.CredsInfo
+		result2 error
+	}
+	credsInfoReturnsOnCall map[int]struct {
+		result1 glide.CredsInfo
+		result2 error
+	}
+	InfoStub        func(context.Context) (glide.Info, error)
+	infoMutex       sync.RWMutex
+	infoArgsForCall []struct {
+		arg1 context.Context
+	}
+	infoReturns struct {
+		result1 glide.Info
+		result2 error
+	}
+	infoReturnsOnCall map[int]struct {
+		result1 glide.Info
+		result2 error
+	}
+	JobStub        func(context.Context, string, string, string) (glide.Job, error)
+	jobMutex       sync.RWMutex
+	jobArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	jobReturns struct {
+		result1 glide.Job
+		result2 error
+	}
+	jobReturnsOnCall map[int]struct {
+		result1 glide.Job
+		result2 error
+	}
+	JobBuildsStub        func(context.Context, string, string, string) ([]glide.Build, error)
+	jobBuildsMutex       sync.RWMutex
+	jobBuildsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	jobBuildsReturns struct {
+		result1 []glide.Build
+		result2 error
+	}
+	jobBuildsReturnsOnCall map[int]struct {
+		result1 []glide.Build
+		result2 error
+	}
+	JobBuildsPageStub        func(context.Context, string, string, string, glide.Page) ([]glide.Build, glide.Pagination, error)
+	jobBuildsPageMutex       sync.RWMutex
+	jobBuildsPageArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 glide.Page
+	}
+	jobBuildsPageReturns struct {
+		result1 []glide.Build
+		result2 glide.Pagination
+		result3 error
+	}
+	jobBuildsPageReturnsOnCall map[int]struct {
+		result1 []glide.Build
+		result2 glide.Pagination
+		result3 error
+	}
+	JobBuildsWithResourceVersionStub        func(context.Context, string, string, string, int) ([]glide.Build, error)
+	jobBuildsWithResourceVersionMutex       sync.RWMutex
+	jobBuildsWithResourceVersionArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 int
+	}
+	jobBuildsWithResourceVersionReturns struct {
+		result1 []glide.Build
+		result2 error
+	}
+	jobBuildsWithResourceVersionReturnsOnCall map[int]struct {
+		result1 []glide.Build
+		result2 error
+	}
+	JobInputsStub        func(context.Context, string, string, string) ([]glide.JobInput, error)
+	jobInputsMutex       sync.RWMutex
+	jobInputsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	jobInputsReturns struct {
+		result1 []glide.JobInput
+		result2 error
+	}
+	jobInputsReturnsOnCall map[int]struct {
+		result1 []glide.JobInput
+		result2 error
+	}
+	JobStatusesStub        func(context.Context, string, string) (map[string]glide.Build, error)
+	jobStatusesMutex       sync.RWMutex
+	jobStatusesArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	jobStatusesReturns struct {
+		result1 map[string]glide.Build
+		result2 error
+	}
+	jobStatusesReturnsOnCall map[int]struct {
+		result1 map[string]glide.Build
+		result2 error
+	}
+	JobsStub        func(context.Context, string, string) ([]glide.Job, error)
+	jobsMutex       sync.RWMutex
+	jobsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	jobsReturns struct {
+		result1 []glide.Job
+		result2 error
+	}
+	jobsReturnsOnCall map[int]struct {
+		result1 []glide.Job
+		result2 error
+	}
+	PipelineStub        func(context.Context, string, string) (glide.Pipeline, error)
+	pipelineMutex       sync.RWMutex
+	pipelineArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	pipelineReturns struct {
+		result1 glide.Pipeline
+		result2 error
+	}
+	pipelineReturnsOnCall map[int]struct {
+		result1 glide.Pipeline
+		result2 error
+	}
+	PipelineBuildsStub        func(context.Context, string, string, glide.Page) ([]glide.Build, glide.Pagination, error)
+	pipelineBuildsMutex       sync.RWMutex
+	pipelineBuildsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 glide.Page
+	}
+	pipelineBuildsReturns struct {
+		result1 []glide.Build
+		result2 glide.Pagination
+		result3 error
+	}
+	pipelineBuildsReturnsOnCall map[int]struct {
+		result1 []glide.Build
+		result2 glide.Pagination
+		result3 error
+	}
+	PipelineConfigStub        func(context.Context, string, string) ([]byte, string, error)
+	pipelineConfigMutex       sync.RWMutex
+	pipelineConfigArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	pipelineConfigReturns struct {
+		result1 []byte
+		result2 string
+		result3 error
+	}
+	pipelineConfigReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 string
+		result3 error
+	}
+	PipelinesStub        func(context.Context, string) ([]glide.Pipeline, error)
+	pipelinesMutex       sync.RWMutex
+	pipelinesArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	pipelinesReturns struct {
+		result1 []glide.Pipeline
+		result2 error
+	}
+	pipelinesReturnsOnCall map[int]struct {
+		result1 []glide.Pipeline
+		result2 error
+	}
+	ResourceStub        func(context.Context, string, string, string) (glide.Resource, error)
+	resourceMutex       sync.RWMutex
+	resourceArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	resourceReturns struct {
+		result1 glide.Resource
+		result2 error
+	}
+	resourceReturnsOnCall map[int]struct {
+		result1 glide.Resource
+		result2 error
+	}
+	ResourceTypesStub        func(context.Context, string, string) ([]glide.ResourceType, error)
+	resourceTypesMutex       sync.RWMutex
+	resourceTypesArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	resourceTypesReturns struct {
+		result1 []glide.ResourceType
+		result2 error
+	}
+	resourceTypesReturnsOnCall map[int]struct {
+		result1 []glide.ResourceType
+		result2 error
+	}
+	ResourceVersionCausalityStub        func(context.Context, string, string, string, int) (glide.Causality, error)
+	resourceVersionCausalityMutex       sync.RWMutex
+	resourceVersionCausalityArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 int
+	}
+	resourceVersionCausalityReturns struct {
+		result1 glide.Causality
+		result2 error
+	}
+	resourceVersionCausalityReturnsOnCall map[int]struct {
+		result1 glide.Causality
+		result2 error
+	}
+	ResourceVersionsStub        func(context.Context, string, string, string) ([]glide.ResourceVersion, error)
+	resourceVersionsMutex       sync.RWMutex
+	resourceVersionsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	resourceVersionsReturns struct {
+		result1 []glide.ResourceVersion
+		result2 error
+	}
+	resourceVersionsReturnsOnCall map[int]struct {
+		result1 []glide.ResourceVersion
+		result2 error
+	}
+	ResourceVersionsPageStub        func(context.Context, string, string, string, glide.Page) ([]glide.ResourceVersion, glide.Pagination, error)
+	resourceVersionsPageMutex       sync.RWMutex
+	resourceVersionsPageArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 glide.Page
+	}
+	resourceVersionsPageReturns struct {
+		result1 []glide.ResourceVersion
+		result2 glide.Pagination
+		result3 error
+	}
+	resourceVersionsPageReturnsOnCall map[int]struct {
+		result1 []glide.ResourceVersion
+		result2 glide.Pagination
+		result3 error
+	}
+	ResourcesStub        func(context.Context, string, string) ([]glide.Resource, error)
+	resourcesMutex       sync.RWMutex
+	resourcesArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	resourcesReturns struct {
+		result1 []glide.Resource
+		result2 error
+	}
+	resourcesReturnsOnCall map[int]struct {
+		result1 []glide.Resource
+		result2 error
+	}
+	TeamBuildsStub        func(context.Context, string, glide.Page) ([]glide.Build, glide.Pagination, error)
+	teamBuildsMutex       sync.RWMutex
+	teamBuildsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 glide.Page
+	}
+	teamBuildsReturns struct {
+		result1 []glide.Build
+		result2 glide.Pagination
+		result3 error
+	}
+	teamBuildsReturnsOnCall map[int]struct {
+		result1 []glide.Build
+		result2 glide.Pagination
+		result3 error
+	}
+	TeamJobsStub        func(context.Context, string) ([]glide.Job, error)
+	teamJobsMutex       sync.RWMutex
+	teamJobsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	teamJobsReturns struct {
+		result1 []glide.Job
+		result2 error
+	}
+	teamJobsReturnsOnCall map[int]struct {
+		result1 []glide.Job
+		result2 error
+	}
+	TeamsStub        func(context.Context) ([]glide.Team, error)
+	teamsMutex       sync.RWMutex
+	teamsArgsForCall []struct {
+		arg1 context.Context
+	}
+	teamsReturns struct {
+		result1 []glide.Team
+		result2 error
+	}
+	teamsReturnsOnCall map[int]struct {
+		result1 []glide.Team
+		result2 error
+	}
+	UserInfoStub        func(context.Context) (glide.UserInfo, error)
+	userInfoMutex       sync.RWMutex
+	userInfoArgsForCall []struct {
+		arg1 context.Context
+	}
+	userInfoReturns struct {
+		result1 glide.UserInfo
+		result2 error
+	}
+	userInfoReturnsOnCall map[int]struct {
+		result1 glide.UserInfo
+		result2 error
+	}
+	VolumesStub        func(context.Context, string) ([]glide.Volume, error)
+	volumesMutex       sync.RWMutex
+	volumesArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	volumesReturns struct {
+		result1 []glide.Volume
+		result2 error
+	}
+	volumesReturnsOnCall map[int]struct {
+		result1 []glide.Volume
+		result2 error
+	}
+	WallStub        func(context.Context) (glide.Wall, error)
+	wallMutex       sync.RWMutex
+	wallArgsForCall []struct {
+		arg1 context.Context
+	}
+	wallReturns struct {
+		result1 glide.Wall
+		result2 error
+	}
+	wallReturnsOnCall map[int]struct {
+		result1 glide.Wall
+		result2 error
+	}
+	WorkersStub        func(context.Context) ([]glide.Worker, error)
+	workersMutex       sync.RWMutex
+	workersArgsForCall []struct {
+		arg1 context.Context
+	}
+	workersReturns struct {
+		result1 []glide.Worker
+		result2 error
+	}
+	workersReturnsOnCall map[int]struct {
+		result1 []glide.Worker
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAPI) AllBuildEvents(arg1 context.Context, arg2 int) ([]glide.BuildEvent, error) {
+	fake.allBuildEventsMutex.Lock()
+	ret, specificReturn := fake.allBuildEventsReturnsOnCall[len(fake.allBuildEventsArgsForCall)]
+	fake.allBuildEventsArgsForCall = append(fake.allBuildEventsArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.AllBuildEventsStub
+	fakeReturns := fake.allBuildEventsReturns
+	fake.recordInvocation("AllBuildEvents", []interface{}{arg1, arg2})
+	fake.allBuildEventsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPI) AllBuildEventsCallCount() int {
+	fake.allBuildEventsMutex.RLock()
+	defer fake.allBuildEventsMutex.RUnlock()
+	return len(fake.allBuildEventsArgsForCall)
+}
+
+func (fake *FakeAPI) AllBuildEventsCalls(stub func(context.Context, int) ([]glide.BuildEvent, error)) {
+	fake.allBuildEventsMutex.Lock()
+	defer fake.allBuildEventsMutex.Unlock()
+	fake.AllBuildEventsStub = stub
+}
+
+func (fake *FakeAPI) AllBuildEventsArgsForCall(i int) (context.Context, int) {
+	fake.allBuildEventsMutex.RLock()
+	defer fake.allBuildEventsMutex.RUnlock()
+	argsForCall := fake.allBuildEventsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAPI) AllBuildEventsReturns(result1 []glide.BuildEvent, result2 error) {
+	fake.allBuildEventsMutex.Lock()
+	defer fake.allBuildEventsMutex.Unlock()
+	fake.AllBuildEventsStub = nil
+	fake.allBuildEventsReturns = struct {
+		result1 []glide.BuildEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) AllBuildEventsReturnsOnCall(i int, result1 []glide.BuildEvent, result2 error) {
+	fake.allBuildEventsMutex.Lock()
+	defer fake.allBuildEventsMutex.Unlock()
+	fake.AllBuildEventsStub = nil
+	if fake.allBuildEventsReturnsOnCall == nil {
+		fake.allBuildEventsReturnsOnCall = make(map[int]struct {
+			result1 []glide.BuildEvent
+			result2 error
+		})
+	}
+	fake.allBuildEventsReturnsOnCall[i] = struct {
+		result1 []glide.BuildEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) AllJobs(arg1 context.Context) ([]glide.Job, error) {
+	fake.allJobsMutex.Lock()
+	ret, specificReturn := fake.allJobsReturnsOnCall[len(fake.allJobsArgsForCall)]
+	fake.allJobsArgsForCall = append(fake.allJobsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.AllJobsStub
+	fakeReturns := fake.allJobsReturns
+	fake.recordInvocation("AllJobs", []interface{}{arg1})
+	fake.allJobsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPI) AllJobsCallCount() int {
+	fake.allJobsMutex.RLock()
+	defer fake.allJobsMutex.RUnlock()
+	return len(fake.allJobsArgsForCall)
+}
+
+func (fake *FakeAPI) AllJobsCalls(stub func(context.Context) ([]glide.Job, error)) {
+	fake.allJobsMutex.Lock()
+	defer fake.allJobsMutex.Unlock()
+	fake.AllJobsStub = stub
+}
+
+func (fake *FakeAPI) AllJobsArgsForCall(i int) context.Context {
+	fake.allJobsMutex.RLock()
+	defer fake.allJobsMutex.RUnlock()
+	argsForCall := fake.allJobsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAPI) AllJobsReturns(result1 []glide.Job, result2 error) {
+	fake.allJobsMutex.Lock()
+	defer fake.allJobsMutex.Unlock()
+	fake.AllJobsStub = nil
+	fake.allJobsReturns = struct {
+		result1 []glide.Job
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) AllJobsReturnsOnCall(i int, result1 []glide.Job, result2 error) {
+	fake.allJobsMutex.Lock()
+	defer fake.allJobsMutex.Unlock()
+	fake.AllJobsStub = nil
+	if fake.allJobsReturnsOnCall == nil {
+		fake.allJobsReturnsOnCall = make(map[int]struct {
+			result1 []glide.Job
+			result2 error
+		})
+	}
+	fake.allJobsReturnsOnCall[i] = struct {
+		result1 []glide.Job
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) AllPipelines(arg1 context.Context) ([]glide.Pipeline, error) {
+	fake.allPipelinesMutex.Lock()
+	ret, specificReturn := fake.allPipelinesReturnsOnCall[len(fake.allPipelinesArgsForCall)]
+	fake.allPipelinesArgsForCall = append(fake.allPipelinesArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.AllPipelinesStub
+	fakeReturns := fake.allPipelinesReturns
+	fake.recordInvocation("AllPipelines", []interface{}{arg1})
+	fake.allPipelinesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPI) AllPipelinesCallCount() int {
+	fake.allPipelinesMutex.RLock()
+	defer fake.allPipelinesMutex.RUnlock()
+	return len(fake.allPipelinesArgsForCall)
+}
+
+func (fake *FakeAPI) AllPipelinesCalls(stub func(context.Context) ([]glide.Pipeline, error)) {
+	fake.allPipelinesMutex.Lock()
+	defer fake.allPipelinesMutex.Unlock()
+	fake.AllPipelinesStub = stub
+}
+
+func (fake *FakeAPI) AllPipelinesArgsForCall(i int) context.Context {
+	fake.allPipelinesMutex.RLock()
+	defer fake.allPipelinesMutex.RUnlock()
+	argsForCall := fake.allPipelinesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAPI) AllPipelinesReturns(result1 []glide.Pipeline, result2 error) {
+	fake.allPipelinesMutex.Lock()
+	defer fake.allPipelinesMutex.Unlock()
+	fake.AllPipelinesStub = nil
+	fake.allPipelinesReturns = struct {
+		result1 []glide.Pipeline
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) AllPipelinesReturnsOnCall(i int, result1 []glide.Pipeline, result2 error) {
+	fake.allPipelinesMutex.Lock()
+	defer fake.allPipelinesMutex.Unlock()
+	fake.AllPipelinesStub = nil
+	if fake.allPipelinesReturnsOnCall == nil {
+		fake.allPipelinesReturnsOnCall = make(map[int]struct {
+			result1 []glide.Pipeline
+			result2 error
+		})
+	}
+	fake.allPipelinesReturnsOnCall[i] = struct {
+		result1 []glide.Pipeline
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) AllPipelinesByTeam(arg1 context.Context, arg2 int) (map[string][]glide.Pipeline, error) {
+	fake.allPipelinesByTeamMutex.Lock()
+	ret, specificReturn := fake.allPipelinesByTeamReturnsOnCall[len(fake.allPipelinesByTeamArgsForCall)]
+	fake.allPipelinesByTeamArgsForCall = append(fake.allPipelinesByTeamArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.AllPipelinesByTeamStub
+	fakeReturns := fake.allPipelinesByTeamReturns
+	fake.recordInvocation("AllPipelinesByTeam", []interface{}{arg1, arg2})
+	fake.allPipelinesByTeamMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPI) AllPipelinesByTeamCallCount() int {
+	fake.allPipelinesByTeamMutex.RLock()
+	defer fake.allPipelinesByTeamMutex.RUnlock()
+	return len(fake.allPipelinesByTeamArgsForCall)
+}
+
+func (fake *FakeAPI) AllPipelinesByTeamCalls(stub func(context.Context, int) (map[string][]glide.Pipeline, error)) {
+	fake.allPipelinesByTeamMutex.Lock()
+	defer fake.allPipelinesByTeamMutex.Unlock()
+	fake.AllPipelinesByTeamStub = stub
+}
+
+func (fake *FakeAPI) AllPipelinesByTeamArgsForCall(i int) (context.Context, int) {
+	fake.allPipelinesByTeamMutex.RLock()
+	defer fake.allPipelinesByTeamMutex.RUnlock()
+	argsForCall := fake.allPipelinesByTeamArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAPI) AllPipelinesByTeamReturns(result1 map[string][]glide.Pipeline, result2 error) {
+	fake.allPipelinesByTeamMutex.Lock()
+	defer fake.allPipelinesByTeamMutex.Unlock()
+	fake.AllPipelinesByTeamStub = nil
+	fake.allPipelinesByTeamReturns = struct {
+		result1 map[string][]glide.Pipeline
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) AllPipelinesByTeamReturnsOnCall(i int, result1 map[string][]glide.Pipeline, result2 error) {
+	fake.allPipelinesByTeamMutex.Lock()
+	defer fake.allPipelinesByTeamMutex.Unlock()
+	fake.AllPipelinesByTeamStub = nil
+	if fake.allPipelinesByTeamReturnsOnCall == nil {
+		fake.allPipelinesByTeamReturnsOnCall = make(map[int]struct {
+			result1 map[string][]glide.Pipeline
+			result2 error
+		})
+	}
+	fake.allPipelinesByTeamReturnsOnCall[i] = struct {
+		result1 map[string][]glide.Pipeline
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) AllResources(arg1 context.Context) ([]glide.Resource, error) {
+	fake.allResourcesMutex.Lock()
+	ret, specificReturn := fake.allResourcesReturnsOnCall[len(fake.allResourcesArgsForCall)]
+	fake.allResourcesArgsForCall = append(fake.allResourcesArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.AllResourcesStub
+	fakeReturns := fake.allResourcesReturns
+	fake.recordInvocation("AllResources", []interface{}{arg1})
+	fake.allResourcesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPI) AllResourcesCallCount() int {
+	fake.allResourcesMutex.RLock()
+	defer fake.allResourcesMutex.RUnlock()
+	return len(fake.allResourcesArgsForCall)
+}
+
+func (fake *FakeAPI) AllResourcesCalls(stub func(context.Context) ([]glide.Resource, error)) {
+	fake.allResourcesMutex.Lock()
+	defer fake.allResourcesMutex.Unlock()
+	fake.AllResourcesStub = stub
+}
+
+func (fake *FakeAPI) AllResourcesArgsForCall(i int) context.Context {
+	fake.allResourcesMutex.RLock()
+	defer fake.allResourcesMutex.RUnlock()
+	argsForCall := fake.allResourcesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAPI) AllResourcesReturns(result1 []glide.Resource, result2 error) {
+	fake.allResourcesMutex.Lock()
+	defer fake.allResourcesMutex.Unlock()
+	fake.AllResourcesStub = nil
+	fake.allResourcesReturns = struct {
+		result1 []glide.Resource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) AllResourcesReturnsOnCall(i int, result1 []glide.Resource, result2 error) {
+	fake.allResourcesMutex.Lock()
+	defer fake.allResourcesMutex.Unlock()
+	fake.AllResourcesStub = nil
+	if fake.allResourcesReturnsOnCall == nil {
+		fake.allResourcesReturnsOnCall = make(map[int]struct {
+			result1 []glide.Resource
+			result2 error
+		})
+	}
+	fake.allResourcesReturnsOnCall[i] = struct {
+		result1 []glide.Resource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) Build(arg1 context.Context, arg2 int) (glide.Build, error) {
+	fake.buildMutex.Lock()
+	ret, specificReturn := fake.buildReturnsOnCall[len(fake.buildArgsForCall)]
+	fake.buildArgsForCall = append(fake.buildArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.BuildStub
+	fakeReturns := fake.buildReturns
+	fake.recordInvocation("Build", []interface{}{arg1, arg2})
+	fake.buildMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPI) BuildCallCount() int {
+	fake.buildMutex.RLock()
+	defer fake.buildMutex.RUnlock()
+	return len(fake.buildArgsForCall)
+}
+
+func (fake *FakeAPI) BuildCalls(stub func(context.Context, int) (glide.Build, error)) {
+	fake.buildMutex.Lock()
+	defer fake.buildMutex.Unlock()
+	fake.BuildStub = stub
+}
+
+func (fake *FakeAPI) BuildArgsForCall(i int) (context.Context, int) {
+	fake.buildMutex.RLock()
+	defer fake.buildMutex.RUnlock()
+	argsForCall := fake.buildArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAPI) BuildReturns(result1 glide.Build, result2 error) {
+	fake.buildMutex.Lock()
+	defer fake.buildMutex.Unlock()
+	fake.BuildStub = nil
+	fake.buildReturns = struct {
+		result1 glide.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) BuildReturnsOnCall(i int, result1 glide.Build, result2 error) {
+	fake.buildMutex.Lock()
+	defer fake.buildMutex.Unlock()
+	fake.BuildStub = nil
+	if fake.buildReturnsOnCall == nil {
+		fake.buildReturnsOnCall = make(map[int]struct {
+			result1 glide.Build
+			result2 error
+		})
+	}
+	fake.buildReturnsOnCall[i] = struct {
+		result1 glide.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) BuildEvents(arg1 context.Context, arg2 int) (<-chan glide.BuildEvent, error) {
+	fake.buildEventsMutex.Lock()
+	ret, specificReturn := fake.buildEventsReturnsOnCall[len(fake.buildEventsArgsForCall)]
+	fake.buildEventsArgsForCall = append(fake.buildEventsArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.BuildEventsStub
+	fakeReturns := fake.buildEventsReturns
+	fake.recordInvocation("BuildEvents", []interface{}{arg1, arg2})
+	fake.buildEventsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPI) BuildEventsCallCount() int {
+	fake.buildEventsMutex.RLock()
+	defer fake.buildEventsMutex.RUnlock()
+	return len(fake.buildEventsArgsForCall)
+}
+
+func (fake *FakeAPI) BuildEventsCalls(stub func(context.Context, int) (<-chan glide.BuildEvent, error)) {
+	fake.buildEventsMutex.Lock()
+	defer fake.buildEventsMutex.Unlock()
+	fake.BuildEventsStub = stub
+}
+
+func (fake *FakeAPI) BuildEventsArgsForCall(i int) (context.Context, int) {
+	fake.buildEventsMutex.RLock()
+	defer fake.buildEventsMutex.RUnlock()
+	argsForCall := fake.buildEventsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAPI) BuildEventsReturns(result1 <-chan glide.BuildEvent, result2 error) {
+	fake.buildEventsMutex.Lock()
+	defer fake.buildEventsMutex.Unlock()
+	fake.BuildEventsStub = nil
+	fake.buildEventsReturns = struct {
+		result1 <-chan glide.BuildEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) BuildEventsReturnsOnCall(i int, result1 <-chan glide.BuildEvent, result2 error) {
+	fake.buildEventsMutex.Lock()
+	defer fake.buildEventsMutex.Unlock()
+	fake.BuildEventsStub = nil
+	if fake.buildEventsReturnsOnCall == nil {
+		fake.buildEventsReturnsOnCall = make(map[int]struct {
+			result1 <-chan glide.BuildEvent
+			result2 error
+		})
+	}
+	fake.buildEventsReturnsOnCall[i] = struct {
+		result1 <-chan glide.BuildEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) BuildLog(arg1 context.Context, arg2 int) (io.ReadCloser, error) {
+	fake.buildLogMutex.Lock()
+	ret, specificReturn := fake.buildLogReturnsOnCall[len(fake.buildLogArgsForCall)]
+	fake.buildLogArgsForCall = append(fake.buildLogArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.BuildLogStub
+	fakeReturns := fake.buildLogReturns
+	fake.recordInvocation("BuildLog", []interface{}{arg1, arg2})
+	fake.buildLogMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPI) BuildLogCallCount() int {
+	fake.buildLogMutex.RLock()
+	defer fake.buildLogMutex.RUnlock()
+	return len(fake.buildLogArgsForCall)
+}
+
+func (fake *FakeAPI) BuildLogCalls(stub func(context.Context, int) (io.ReadCloser, error)) {
+	fake.buildLogMutex.Lock()
+	defer fake.buildLogMutex.Unlock()
+	fake.BuildLogStub = stub
+}
+
+func (fake *FakeAPI) BuildLogArgsForCall(i int) (context.Context, int) {
+	fake.buildLogMutex.RLock()
+	defer fake.buildLogMutex.RUnlock()
+	argsForCall := fake.buildLogArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAPI) BuildLogReturns(result1 io.ReadCloser, result2 error) {
+	fake.buildLogMutex.Lock()
+	defer fake.buildLogMutex.Unlock()
+	fake.BuildLogStub = nil
+	fake.buildLogReturns = struct {
+		result1 io.ReadCloser
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) BuildLogReturnsOnCall(i int, result1 io.ReadCloser, result2 error) {
+	fake.buildLogMutex.Lock()
+	defer fake.buildLogMutex.Unlock()
+	fake.BuildLogStub = nil
+	if fake.buildLogReturnsOnCall == nil {
+		fake.buildLogReturnsOnCall = make(map[int]struct {
+			result1 io.ReadCloser
+			result2 error
+		})
+	}
+	fake.buildLogReturnsOnCall[i] = struct {
+		result1 io.ReadCloser
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) BuildPlan(arg1 context.Context, arg2 int) (json.RawMessage, string, error) {
+	fake.buildPlanMutex.Lock()
+	ret, specificReturn := fake.buildPlanReturnsOnCall[len(fake.buildPlanArgsForCall)]
+	fake.buildPlanArgsForCall = append(fake.buildPlanArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.BuildPlanStub
+	fakeReturns := fake.buildPlanReturns
+	fake.recordInvocation("BuildPlan", []interface{}{arg1, arg2})
+	fake.buildPlanMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeAPI) BuildPlanCallCount() int {
+	fake.buildPlanMutex.RLock()
+	defer fake.buildPlanMutex.RUnlock()
+	return len(fake.buildPlanArgsForCall)
+}
+
+func (fake *FakeAPI) BuildPlanCalls(stub func(context.Context, int) (json.RawMessage, string, error)) {
+	fake.buildPlanMutex.Lock()
+	defer fake.buildPlanMutex.Unlock()
+	fake.BuildPlanStub = stub
+}
+
+func (fake *FakeAPI) BuildPlanArgsForCall(i int) (context.Context, int) {
+	fake.buildPlanMutex.RLock()
+	defer fake.buildPlanMutex.RUnlock()
+	argsForCall := fake.buildPlanArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAPI) BuildPlanReturns(result1 json.RawMessage, result2 string, result3 error) {
+	fake.buildPlanMutex.Lock()
+	defer fake.buildPlanMutex.Unlock()
+	fake.BuildPlanStub = nil
+	fake.buildPlanReturns = struct {
+		result1 json.RawMessage
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAPI) BuildPlanReturnsOnCall(i int, result1 json.RawMessage, result2 string, result3 error) {
+	fake.buildPlanMutex.Lock()
+	defer fake.buildPlanMutex.Unlock()
+	fake.BuildPlanStub = nil
+	if fake.buildPlanReturnsOnCall == nil {
+		fake.buildPlanReturnsOnCall = make(map[int]struct {
+			result1 json.RawMessage
+			result2 string
+			result3 error
+		})
+	}
+	fake.buildPlanReturnsOnCall[i] = struct {
+		result1 json.RawMessage
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAPI) BuildPreparation(arg1 context.Context, arg2 int) (glide.BuildPreparation, error) {
+	fake.buildPreparationMutex.Lock()
+	ret, specificReturn := fake.buildPreparationReturnsOnCall[len(fake.buildPreparationArgsForCall)]
+	fake.buildPreparationArgsForCall = append(fake.buildPreparationArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.BuildPreparationStub
+	fakeReturns := fake.buildPreparationReturns
+	fake.recordInvocation("BuildPreparation", []interface{}{arg1, arg2})
+	fake.buildPreparationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPI) BuildPreparationCallCount() int {
+	fake.buildPreparationMutex.RLock()
+	defer fake.buildPreparationMutex.RUnlock()
+	return len(fake.buildPreparationArgsForCall)
+}
+
+func (fake *FakeAPI) BuildPreparationCalls(stub func(context.Context, int) (glide.BuildPreparation, error)) {
+	fake.buildPreparationMutex.Lock()
+	defer fake.buildPreparationMutex.Unlock()
+	fake.BuildPreparationStub = stub
+}
+
+func (fake *FakeAPI) BuildPreparationArgsForCall(i int) (context.Context, int) {
+	fake.buildPreparationMutex.RLock()
+	defer fake.buildPreparationMutex.RUnlock()
+	argsForCall := fake.buildPreparationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAPI) BuildPreparationReturns(result1 glide.BuildPreparation, result2 error) {
+	fake.buildPreparationMutex.Lock()
+	defer fake.buildPreparationMutex.Unlock()
+	fake.BuildPreparationStub = nil
+	fake.buildPreparationReturns = struct {
+		result1 glide.BuildPreparation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) BuildPreparationReturnsOnCall(i int, result1 glide.BuildPreparation, result2 error) {
+	fake.buildPreparationMutex.Lock()
+	defer fake.buildPreparationMutex.Unlock()
+	fake.BuildPreparationStub = nil
+	if fake.buildPreparationReturnsOnCall == nil {
+		fake.buildPreparationReturnsOnCall = make(map[int]struct {
+			result1 glide.BuildPreparation
+			result2 error
+		})
+	}
+	fake.buildPreparationReturnsOnCall[i] = struct {
+		result1 glide.BuildPreparation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) BuildResources(arg1 context.Context, arg2 int) (glide.BuildResources, error) {
+	fake.buildResourcesMutex.Lock()
+	ret, specificReturn := fake.buildResourcesReturnsOnCall[len(fake.buildResourcesArgsForCall)]
+	fake.buildResourcesArgsForCall = append(fake.buildResourcesArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.BuildResourcesStub
+	fakeReturns := fake.buildResourcesReturns
+	fake.recordInvocation("BuildResources", []interface{}{arg1, arg2})
+	fake.buildResourcesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPI) BuildResourcesCallCount() int {
+	fake.buildResourcesMutex.RLock()
+	defer fake.buildResourcesMutex.RUnlock()
+	return len(fake.buildResourcesArgsForCall)
+}
+
+func (fake *FakeAPI) BuildResourcesCalls(stub func(context.Context, int) (glide.BuildResources, error)) {
+	fake.buildResourcesMutex.Lock()
+	defer fake.buildResourcesMutex.Unlock()
+	fake.BuildResourcesStub = stub
+}
+
+func (fake *FakeAPI) BuildResourcesArgsForCall(i int) (context.Context, int) {
+	fake.buildResourcesMutex.RLock()
+	defer fake.buildResourcesMutex.RUnlock()
+	argsForCall := fake.buildResourcesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAPI) BuildResourcesReturns(result1 glide.BuildResources, result2 error) {
+	fake.buildResourcesMutex.Lock()
+	defer fake.buildResourcesMutex.Unlock()
+	fake.BuildResourcesStub = nil
+	fake.buildResourcesReturns = struct {
+		result1 glide.BuildResources
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) BuildResourcesReturnsOnCall(i int, result1 glide.BuildResources, result2 error) {
+	fake.buildResourcesMutex.Lock()
+	defer fake.buildResourcesMutex.Unlock()
+	fake.BuildResourcesStub = nil
+	if fake.buildResourcesReturnsOnCall == nil {
+		fake.buildResourcesReturnsOnCall = make(map[int]struct {
+			result1 glide.BuildResources
+			result2 error
+		})
+	}
+	fake.buildResourcesReturnsOnCall[i] = struct {
+		result1 glide.BuildResources
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) Builds(arg1 context.Context, arg2 glide.Page) ([]glide.Build, glide.Pagination, error) {
+	fake.buildsMutex.Lock()
+	ret, specificReturn := fake.buildsReturnsOnCall[len(fake.buildsArgsForCall)]
+	fake.buildsArgsForCall = append(fake.buildsArgsForCall, struct {
+		arg1 context.Context
+		arg2 glide.Page
+	}{arg1, arg2})
+	stub := fake.BuildsStub
+	fakeReturns := fake.buildsReturns
+	fake.recordInvocation("Builds", []interface{}{arg1, arg2})
+	fake.buildsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeAPI) BuildsCallCount() int {
+	fake.buildsMutex.RLock()
+	defer fake.buildsMutex.RUnlock()
+	return len(fake.buildsArgsForCall)
+}
+
+func (fake *FakeAPI) BuildsCalls(stub func(context.Context, glide.Page) ([]glide.Build, glide.Pagination, error)) {
+	fake.buildsMutex.Lock()
+	defer fake.buildsMutex.Unlock()
+	fake.BuildsStub = stub
+}
+
+func (fake *FakeAPI) BuildsArgsForCall(i int) (context.Context, glide.Page) {
+	fake.buildsMutex.RLock()
+	defer fake.buildsMutex.RUnlock()
+	argsForCall := fake.buildsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAPI) BuildsReturns(result1 []glide.Build, result2 glide.Pagination, result3 error) {
+	fake.buildsMutex.Lock()
+	defer fake.buildsMutex.Unlock()
+	fake.BuildsStub = nil
+	fake.buildsReturns = struct {
+		result1 []glide.Build
+		result2 glide.Pagination
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAPI) BuildsReturnsOnCall(i int, result1 []glide.Build, result2 glide.Pagination, result3 error) {
+	fake.buildsMutex.Lock()
+	defer fake.buildsMutex.Unlock()
+	fake.BuildsStub = nil
+	if fake.buildsReturnsOnCall == nil {
+		fake.buildsReturnsOnCall = make(map[int]struct {
+			result1 []glide.Build
+			result2 glide.Pagination
+			result3 error
+		})
+	}
+	fake.buildsReturnsOnCall[i] = struct {
+		result1 []glide.Build
+		result2 glide.Pagination
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAPI) BuildsWithResourceVersionAsOutput(arg1 context.Context, arg2 string, arg3 string, arg4 string, arg5 int) ([]glide.Build, error) {
+	fake.buildsWithResourceVersionAsOutputMutex.Lock()
+	ret, specificReturn := fake.buildsWithResourceVersionAsOutputReturnsOnCall[len(fake.buildsWithResourceVersionAsOutputArgsForCall)]
+	fake.buildsWithResourceVersionAsOutputArgsForCall = append(fake.buildsWithResourceVersionAsOutputArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 int
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.BuildsWithResourceVersionAsOutputStub
+	fakeReturns := fake.buildsWithResourceVersionAsOutputReturns
+	fake.recordInvocation("BuildsWithResourceVersionAsOutput", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.buildsWithResourceVersionAsOutputMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPI) BuildsWithResourceVersionAsOutputCallCount() int {
+	fake.buildsWithResourceVersionAsOutputMutex.RLock()
+	defer fake.buildsWithResourceVersionAsOutputMutex.RUnlock()
+	return len(fake.buildsWithResourceVersionAsOutputArgsForCall)
+}
+
+func (fake *FakeAPI) BuildsWithResourceVersionAsOutputCalls(stub func(context.Context, string, string, string, int) ([]glide.Build, error)) {
+	fake.buildsWithResourceVersionAsOutputMutex.Lock()
+	defer fake.buildsWithResourceVersionAsOutputMutex.Unlock()
+	fake.BuildsWithResourceVersionAsOutputStub = stub
+}
+
+func (fake *FakeAPI) BuildsWithResourceVersionAsOutputArgsForCall(i int) (context.Context, string, string, string, int) {
+	fake.buildsWithResourceVersionAsOutputMutex.RLock()
+	defer fake.buildsWithResourceVersionAsOutputMutex.RUnlock()
+	argsForCall := fake.buildsWithResourceVersionAsOutputArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeAPI) BuildsWithResourceVersionAsOutputReturns(result1 []glide.Build, result2 error) {
+	fake.buildsWithResourceVersionAsOutputMutex.Lock()
+	defer fake.buildsWithResourceVersionAsOutputMutex.Unlock()
+	fake.BuildsWithResourceVersionAsOutputStub = nil
+	fake.buildsWithResourceVersionAsOutputReturns = struct {
+		result1 []glide.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) BuildsWithResourceVersionAsOutputReturnsOnCall(i int, result1 []glide.Build, result2 error) {
+	fake.buildsWithResourceVersionAsOutputMutex.Lock()
+	defer fake.buildsWithResourceVersionAsOutputMutex.Unlock()
+	fake.BuildsWithResourceVersionAsOutputStub = nil
+	if fake.buildsWithResourceVersionAsOutputReturnsOnCall == nil {
+		fake.buildsWithResourceVersionAsOutputReturnsOnCall = make(map[int]struct {
+			result1 []glide.Build
+			result2 error
+		})
+	}
+	fake.buildsWithResourceVersionAsOutputReturnsOnCall[i] = struct {
+		result1 []glide.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) Container(arg1 context.Context, arg2 string, arg3 string) (glide.Container, error) {
+	fake.containerMutex.Lock()
+	ret, specificReturn := fake.containerReturnsOnCall[len(fake.containerArgsForCall)]
+	fake.containerArgsForCall = append(fake.containerArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ContainerStub
+	fakeReturns := fake.containerReturns
+	fake.recordInvocation("Container", []interface{}{arg1, arg2, arg3})
+	fake.containerMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPI) ContainerCallCount() int {
+	fake.containerMutex.RLock()
+	defer fake.containerMutex.RUnlock()
+	return len(fake.containerArgsForCall)
+}
+
+func (fake *FakeAPI) ContainerCalls(stub func(context.Context, string, string) (glide.Container, error)) {
+	fake.containerMutex.Lock()
+	defer fake.containerMutex.Unlock()
+	fake.ContainerStub = stub
+}
+
+func (fake *FakeAPI) ContainerArgsForCall(i int) (context.Context, string, string) {
+	fake.containerMutex.RLock()
+	defer fake.containerMutex.RUnlock()
+	argsForCall := fake.containerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeAPI) ContainerReturns(result1 glide.Container, result2 error) {
+	fake.containerMutex.Lock()
+	defer fake.containerMutex.Unlock()
+	fake.ContainerStub = nil
+	fake.containerReturns = struct {
+		result1 glide.Container
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) ContainerReturnsOnCall(i int, result1 glide.Container, result2 error) {
+	fake.containerMutex.Lock()
+	defer fake.containerMutex.Unlock()
+	fake.ContainerStub = nil
+	if fake.containerReturnsOnCall == nil {
+		fake.containerReturnsOnCall = make(map[int]struct {
+			result1 glide.Container
+			result2 error
+		})
+	}
+	fake.containerReturnsOnCall[i] = struct {
+		result1 glide.Container
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) Containers(arg1 context.Context, arg2 string, arg3 glide.ContainerFilter) ([]glide.Container, error) {
+	fake.containersMutex.Lock()
+	ret, specificReturn := fake.containersReturnsOnCall[len(fake.containersArgsForCall)]
+	fake.containersArgsForCall = append(fake.containersArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 glide.ContainerFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ContainersStub
+	fakeReturns := fake.containersReturns
+	fake.recordInvocation("Containers", []interface{}{arg1, arg2, arg3})
+	fake.containersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPI) ContainersCallCount() int {
+	fake.containersMutex.RLock()
+	defer fake.containersMutex.RUnlock()
+	return len(fake.containersArgsForCall)
+}
+
+func (fake *FakeAPI) ContainersCalls(stub func(context.Context, string, glide.ContainerFilter) ([]glide.Container, error)) {
+	fake.containersMutex.Lock()
+	defer fake.containersMutex.Unlock()
+	fake.ContainersStub = stub
+}
+
+func (fake *FakeAPI) ContainersArgsForCall(i int) (context.Context, string, glide.ContainerFilter) {
+	fake.containersMutex.RLock()
+	defer fake.containersMutex.RUnlock()
+	argsForCall := fake.containersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeAPI) ContainersReturns(result1 []glide.Container, result2 error) {
+	fake.containersMutex.Lock()
+	defer fake.containersMutex.Unlock()
+	fake.ContainersStub = nil
+	fake.containersReturns = struct {
+		result1 []glide.Container
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) ContainersReturnsOnCall(i int, result1 []glide.Container, result2 error) {
+	fake.containersMutex.Lock()
+	defer fake.containersMutex.Unlock()
+	fake.ContainersStub = nil
+	if fake.containersReturnsOnCall == nil {
+		fake.containersReturnsOnCall = make(map[int]struct {
+			result1 []glide.Container
+			result2 error
+		})
+	}
+	fake.containersReturnsOnCall[i] = struct {
+		result1 []glide.Container
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) CredsInfo(arg1 context.Context) (glide.CredsInfo, error) {
+	fake.credsInfoMutex.Lock()
+	ret, specificReturn := fake.credsInfoReturnsOnCall[len(fake.credsInfoArgsForCall)]
+	fake.credsInfoArgsForCall = append(fake.credsInfoArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.CredsInfoStub
+	fakeReturns := fake.credsInfoReturns
+	fake.recordInvocation("CredsInfo", []interface{}{arg1})
+	fake.credsInfoMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPI) CredsInfoCallCount() int {
+	fake.credsInfoMutex.RLock()
+	defer fake.credsInfoMutex.RUnlock()
+	return len(fake.credsInfoArgsForCall)
+}
+
+func (fake *FakeAPI) CredsInfoCalls(stub func(context.Context) (glide.CredsInfo, error)) {
+	fake.credsInfoMutex.Lock()
+	defer fake.credsInfoMutex.Unlock()
+	fake.CredsInfoStub = stub
+}
+
+func (fake *FakeAPI) CredsInfoArgsForCall(i int) context.Context {
+	fake.credsInfoMutex.RLock()
+	defer fake.credsInfoMutex.RUnlock()
+	argsForCall := fake.credsInfoArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAPI) CredsInfoReturns(result1 glide.CredsInfo, result2 error) {
+	fake.credsInfoMutex.Lock()
+	defer fake.credsInfoMutex.Unlock()
+	fake.CredsInfoStub = nil
+	fake.credsInfoReturns = struct {
+		result1 glide.CredsInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) CredsInfoReturnsOnCall(i int, result1 glide.CredsInfo, result2 error) {
+	fake.credsInfoMutex.Lock()
+	defer fake.credsInfoMutex.Unlock()
+	fake.CredsInfoStub = nil
+	if fake.credsInfoReturnsOnCall == nil {
+		fake.credsInfoReturnsOnCall = make(map[int]struct {
+			result1 glide.CredsInfo
+			result2 error
+		})
+	}
+	fake.credsInfoReturnsOnCall[i] = struct {
+		result1 glide.CredsInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) Info(arg1 context.Context) (glide.Info, error) {
+	fake.infoMutex.Lock()
+	ret, specificReturn := fake.infoReturnsOnCall[len(fake.infoArgsForCall)]
+	fake.infoArgsForCall = append(fake.infoArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.InfoStub
+	fakeReturns := fake.infoReturns
+	fake.recordInvocation("Info", []interface{}{arg1})
+	fake.infoMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPI) InfoCallCount() int {
+	fake.infoMutex.RLock()
+	defer fake.infoMutex.RUnlock()
+	return len(fake.infoArgsForCall)
+}
+
+func (fake *FakeAPI) InfoCalls(stub func(context.Context) (glide.Info, error)) {
+	fake.infoMutex.Lock()
+	defer fake.infoMutex.Unlock()
+	fake.InfoStub = stub
+}
+
+func (fake *FakeAPI) InfoArgsForCall(i int) context.Context {
+	fake.infoMutex.RLock()
+	defer fake.infoMutex.RUnlock()
+	argsForCall := fake.infoArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAPI) InfoReturns(result1 glide.Info, result2 error) {
+	fake.infoMutex.Lock()
+	defer fake.infoMutex.Unlock()
+	fake.InfoStub = nil
+	fake.infoReturns = struct {
+		result1 glide.Info
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) InfoReturnsOnCall(i int, result1 glide.Info, result2 error) {
+	fake.infoMutex.Lock()
+	defer fake.infoMutex.Unlock()
+	fake.InfoStub = nil
+	if fake.infoReturnsOnCall == nil {
+		fake.infoReturnsOnCall = make(map[int]struct {
+			result1 glide.Info
+			result2 error
+		})
+	}
+	fake.infoReturnsOnCall[i] = struct {
+		result1 glide.Info
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) Job(arg1 context.Context, arg2 string, arg3 string, arg4 string) (glide.Job, error) {
+	fake.jobMutex.Lock()
+	ret, specificReturn := fake.jobReturnsOnCall[len(fake.jobArgsForCall)]
+	fake.jobArgsForCall = append(fake.jobArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.JobStub
+	fakeReturns := fake.jobReturns
+	fake.recordInvocation("Job", []interface{}{arg1, arg2, arg3, arg4})
+	fake.jobMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPI) JobCallCount() int {
+	fake.jobMutex.RLock()
+	defer fake.jobMutex.RUnlock()
+	return len(fake.jobArgsForCall)
+}
+
+func (fake *FakeAPI) JobCalls(stub func(context.Context, string, string, string) (glide.Job, error)) {
+	fake.jobMutex.Lock()
+	defer fake.jobMutex.Unlock()
+	fake.JobStub = stub
+}
+
+func (fake *FakeAPI) JobArgsForCall(i int) (context.Context, string, string, string) {
+	fake.jobMutex.RLock()
+	defer fake.jobMutex.RUnlock()
+	argsForCall := fake.jobArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeAPI) JobReturns(result1 glide.Job, result2 error) {
+	fake.jobMutex.Lock()
+	defer fake.jobMutex.Unlock()
+	fake.JobStub = nil
+	fake.jobReturns = struct {
+		result1 glide.Job
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) JobReturnsOnCall(i int, result1 glide.Job, result2 error) {
+	fake.jobMutex.Lock()
+	defer fake.jobMutex.Unlock()
+	fake.JobStub = nil
+	if fake.jobReturnsOnCall == nil {
+		fake.jobReturnsOnCall = make(map[int]struct {
+			result1 glide.Job
+			result2 error
+		})
+	}
+	fake.jobReturnsOnCall[i] = struct {
+		result1 glide.Job
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) JobBuilds(arg1 context.Context, arg2 string, arg3 string, arg4 string) ([]glide.Build, error) {
+	fake.jobBuildsMutex.Lock()
+	ret, specificReturn := fake.jobBuildsReturnsOnCall[len(fake.jobBuildsArgsForCall)]
+	fake.jobBuildsArgsForCall = append(fake.jobBuildsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.JobBuildsStub
+	fakeReturns := fake.jobBuildsReturns
+	fake.recordInvocation("JobBuilds", []interface{}{arg1, arg2, arg3, arg4})
+	fake.jobBuildsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPI) JobBuildsCallCount() int {
+	fake.jobBuildsMutex.RLock()
+	defer fake.jobBuildsMutex.RUnlock()
+	return len(fake.jobBuildsArgsForCall)
+}
+
+func (fake *FakeAPI) JobBuildsCalls(stub func(context.Context, string, string, string) ([]glide.Build, error)) {
+	fake.jobBuildsMutex.Lock()
+	defer fake.jobBuildsMutex.Unlock()
+	fake.JobBuildsStub = stub
+}
+
+func (fake *FakeAPI) JobBuildsArgsForCall(i int) (context.Context, string, string, string) {
+	fake.jobBuildsMutex.RLock()
+	defer fake.jobBuildsMutex.RUnlock()
+	argsForCall := fake.jobBuildsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeAPI) JobBuildsReturns(result1 []glide.Build, result2 error) {
+	fake.jobBuildsMutex.Lock()
+	defer fake.jobBuildsMutex.Unlock()
+	fake.JobBuildsStub = nil
+	fake.jobBuildsReturns = struct {
+		result1 []glide.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) JobBuildsReturnsOnCall(i int, result1 []glide.Build, result2 error) {
+	fake.jobBuildsMutex.Lock()
+	defer fake.jobBuildsMutex.Unlock()
+	fake.JobBuildsStub = nil
+	if fake.jobBuildsReturnsOnCall == nil {
+		fake.jobBuildsReturnsOnCall = make(map[int]struct {
+			result1 []glide.Build
+			result2 error
+		})
+	}
+	fake.jobBuildsReturnsOnCall[i] = struct {
+		result1 []glide.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) JobBuildsPage(arg1 context.Context, arg2 string, arg3 string, arg4 string, arg5 glide.Page) ([]glide.Build, glide.Pagination, error) {
+	fake.jobBuildsPageMutex.Lock()
+	ret, specificReturn := fake.jobBuildsPageReturnsOnCall[len(fake.jobBuildsPageArgsForCall)]
+	fake.jobBuildsPageArgsForCall = append(fake.jobBuildsPageArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 glide.Page
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.JobBuildsPageStub
+	fakeReturns := fake.jobBuildsPageReturns
+	fake.recordInvocation("JobBuildsPage", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.jobBuildsPageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeAPI) JobBuildsPageCallCount() int {
+	fake.jobBuildsPageMutex.RLock()
+	defer fake.jobBuildsPageMutex.RUnlock()
+	return len(fake.jobBuildsPageArgsForCall)
+}
+
+func (fake *FakeAPI) JobBuildsPageCalls(stub func(context.Context, string, string, string, glide.Page) ([]glide.Build, glide.Pagination, error)) {
+	fake.jobBuildsPageMutex.Lock()
+	defer fake.jobBuildsPageMutex.Unlock()
+	fake.JobBuildsPageStub = stub
+}
+
+func (fake *FakeAPI) JobBuildsPageArgsForCall(i int) (context.Context, string, string, string, glide.Page) {
+	fake.jobBuildsPageMutex.RLock()
+	defer fake.jobBuildsPageMutex.RUnlock()
+	argsForCall := fake.jobBuildsPageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeAPI) JobBuildsPageReturns(result1 []glide.Build, result2 glide.Pagination, result3 error) {
+	fake.jobBuildsPageMutex.Lock()
+	defer fake.jobBuildsPageMutex.Unlock()
+	fake.JobBuildsPageStub = nil
+	fake.jobBuildsPageReturns = struct {
+		result1 []glide.Build
+		result2 glide.Pagination
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAPI) JobBuildsPageReturnsOnCall(i int, result1 []glide.Build, result2 glide.Pagination, result3 error) {
+	fake.jobBuildsPageMutex.Lock()
+	defer fake.jobBuildsPageMutex.Unlock()
+	fake.JobBuildsPageStub = nil
+	if fake.jobBuildsPageReturnsOnCall == nil {
+		fake.jobBuildsPageReturnsOnCall = make(map[int]struct {
+			result1 []glide.Build
+			result2 glide.Pagination
+			result3 error
+		})
+	}
+	fake.jobBuildsPageReturnsOnCall[i] = struct {
+		result1 []glide.Build
+		result2 glide.Pagination
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAPI) JobBuildsWithResourceVersion(arg1 context.Context, arg2 string, arg3 string, arg4 string, arg5 int) ([]glide.Build, error) {
+	fake.jobBuildsWithResourceVersionMutex.Lock()
+	ret, specificReturn := fake.jobBuildsWithResourceVersionReturnsOnCall[len(fake.jobBuildsWithResourceVersionArgsForCall)]
+	fake.jobBuildsWithResourceVersionArgsForCall = append(fake.jobBuildsWithResourceVersionArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 int
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.JobBuildsWithResourceVersionStub
+	fakeReturns := fake.jobBuildsWithResourceVersionReturns
+	fake.recordInvocation("JobBuildsWithResourceVersion", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.jobBuildsWithResourceVersionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPI) JobBuildsWithResourceVersionCallCount() int {
+	fake.jobBuildsWithResourceVersionMutex.RLock()
+	defer fake.jobBuildsWithResourceVersionMutex.RUnlock()
+	return len(fake.jobBuildsWithResourceVersionArgsForCall)
+}
+
+func (fake *FakeAPI) JobBuildsWithResourceVersionCalls(stub func(context.Context, string, string, string, int) ([]glide.Build, error)) {
+	fake.jobBuildsWithResourceVersionMutex.Lock()
+	defer fake.jobBuildsWithResourceVersionMutex.Unlock()
+	fake.JobBuildsWithResourceVersionStub = stub
+}
+
+func (fake *FakeAPI) JobBuildsWithResourceVersionArgsForCall(i int) (context.Context, string, string, string, int) {
+	fake.jobBuildsWithResourceVersionMutex.RLock()
+	defer fake.jobBuildsWithResourceVersionMutex.RUnlock()
+	argsForCall := fake.jobBuildsWithResourceVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeAPI) JobBuildsWithResourceVersionReturns(result1 []glide.Build, result2 error) {
+	fake.jobBuildsWithResourceVersionMutex.Lock()
+	defer fake.jobBuildsWithResourceVersionMutex.Unlock()
+	fake.JobBuildsWithResourceVersionStub = nil
+	fake.jobBuildsWithResourceVersionReturns = struct {
+		result1 []glide.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) JobBuildsWithResourceVersionReturnsOnCall(i int, result1 []glide.Build, result2 error) {
+	fake.jobBuildsWithResourceVersionMutex.Lock()
+	defer fake.jobBuildsWithResourceVersionMutex.Unlock()
+	fake.JobBuildsWithResourceVersionStub = nil
+	if fake.jobBuildsWithResourceVersionReturnsOnCall == nil {
+		fake.jobBuildsWithResourceVersionReturnsOnCall = make(map[int]struct {
+			result1 []glide.Build
+			result2 error
+		})
+	}
+	fake.jobBuildsWithResourceVersionReturnsOnCall[i] = struct {
+		result1 []glide.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) JobInputs(arg1 context.Context, arg2 string, arg3 string, arg4 string) ([]glide.JobInput, error) {
+	fake.jobInputsMutex.Lock()
+	ret, specificReturn := fake.jobInputsReturnsOnCall[len(fake.jobInputsArgsForCall)]
+	fake.jobInputsArgsForCall = append(fake.jobInputsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.JobInputsStub
+	fakeReturns := fake.jobInputsReturns
+	fake.recordInvocation("JobInputs", []interface{}{arg1, arg2, arg3, arg4})
+	fake.jobInputsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPI) JobInputsCallCount() int {
+	fake.jobInputsMutex.RLock()
+	defer fake.jobInputsMutex.RUnlock()
+	return len(fake.jobInputsArgsForCall)
+}
+
+func (fake *FakeAPI) JobInputsCalls(stub func(context.Context, string, string, string) ([]glide.JobInput, error)) {
+	fake.jobInputsMutex.Lock()
+	defer fake.jobInputsMutex.Unlock()
+	fake.JobInputsStub = stub
+}
+
+func (fake *FakeAPI) JobInputsArgsForCall(i int) (context.Context, string, string, string) {
+	fake.jobInputsMutex.RLock()
+	defer fake.jobInputsMutex.RUnlock()
+	argsForCall := fake.jobInputsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeAPI) JobInputsReturns(result1 []glide.JobInput, result2 error) {
+	fake.jobInputsMutex.Lock()
+	defer fake.jobInputsMutex.Unlock()
+	fake.JobInputsStub = nil
+	fake.jobInputsReturns = struct {
+		result1 []glide.JobInput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) JobInputsReturnsOnCall(i int, result1 []glide.JobInput, result2 error) {
+	fake.jobInputsMutex.Lock()
+	defer fake.jobInputsMutex.Unlock()
+	fake.JobInputsStub = nil
+	if fake.jobInputsReturnsOnCall == nil {
+		fake.jobInputsReturnsOnCall = make(map[int]struct {
+			result1 []glide.JobInput
+			result2 error
+		})
+	}
+	fake.jobInputsReturnsOnCall[i] = struct {
+		result1 []glide.JobInput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) JobStatuses(arg1 context.Context, arg2 string, arg3 string) (map[string]glide.Build, error) {
+	fake.jobStatusesMutex.Lock()
+	ret, specificReturn := fake.jobStatusesReturnsOnCall[len(fake.jobStatusesArgsForCall)]
+	fake.jobStatusesArgsForCall = append(fake.jobStatusesArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.JobStatusesStub
+	fakeReturns := fake.jobStatusesReturns
+	fake.recordInvocation("JobStatuses", []interface{}{arg1, arg2, arg3})
+	fake.jobStatusesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPI) JobStatusesCallCount() int {
+	fake.jobStatusesMutex.RLock()
+	defer fake.jobStatusesMutex.RUnlock()
+	return len(fake.jobStatusesArgsForCall)
+}
+
+func (fake *FakeAPI) JobStatusesCalls(stub func(context.Context, string, string) (map[string]glide.Build, error)) {
+	fake.jobStatusesMutex.Lock()
+	defer fake.jobStatusesMutex.Unlock()
+	fake.JobStatusesStub = stub
+}
+
+func (fake *FakeAPI) JobStatusesArgsForCall(i int) (context.Context, string, string) {
+	fake.jobStatusesMutex.RLock()
+	defer fake.jobStatusesMutex.RUnlock()
+	argsForCall := fake.jobStatusesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeAPI) JobStatusesReturns(result1 map[string]glide.Build, result2 error) {
+	fake.jobStatusesMutex.Lock()
+	defer fake.jobStatusesMutex.Unlock()
+	fake.JobStatusesStub = nil
+	fake.jobStatusesReturns = struct {
+		result1 map[string]glide.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) JobStatusesReturnsOnCall(i int, result1 map[string]glide.Build, result2 error) {
+	fake.jobStatusesMutex.Lock()
+	defer fake.jobStatusesMutex.Unlock()
+	fake.JobStatusesStub = nil
+	if fake.jobStatusesReturnsOnCall == nil {
+		fake.jobStatusesReturnsOnCall = make(map[int]struct {
+			result1 map[string]glide.Build
+			result2 error
+		})
+	}
+	fake.jobStatusesReturnsOnCall[i] = struct {
+		result1 map[string]glide.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) Jobs(arg1 context.Context, arg2 string, arg3 string) ([]glide.Job, error) {
+	fake.jobsMutex.Lock()
+	ret, specificReturn := fake.jobsReturnsOnCall[len(fake.jobsArgsForCall)]
+	fake.jobsArgsForCall = append(fake.jobsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.JobsStub
+	fakeReturns := fake.jobsReturns
+	fake.recordInvocation("Jobs", []interface{}{arg1, arg2, arg3})
+	fake.jobsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPI) JobsCallCount() int {
+	fake.jobsMutex.RLock()
+	defer fake.jobsMutex.RUnlock()
+	return len(fake.jobsArgsForCall)
+}
+
+func (fake *FakeAPI) JobsCalls(stub func(context.Context, string, string) ([]glide.Job, error)) {
+	fake.jobsMutex.Lock()
+	defer fake.jobsMutex.Unlock()
+	fake.JobsStub = stub
+}
+
+func (fake *FakeAPI) JobsArgsForCall(i int) (context.Context, string, string) {
+	fake.jobsMutex.RLock()
+	defer fake.jobsMutex.RUnlock()
+	argsForCall := fake.jobsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeAPI) JobsReturns(result1 []glide.Job, result2 error) {
+	fake.jobsMutex.Lock()
+	defer fake.jobsMutex.Unlock()
+	fake.JobsStub = nil
+	fake.jobsReturns = struct {
+		result1 []glide.Job
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) JobsReturnsOnCall(i int, result1 []glide.Job, result2 error) {
+	fake.jobsMutex.Lock()
+	defer fake.jobsMutex.Unlock()
+	fake.JobsStub = nil
+	if fake.jobsReturnsOnCall == nil {
+		fake.jobsReturnsOnCall = make(map[int]struct {
+			result1 []glide.Job
+			result2 error
+		})
+	}
+	fake.jobsReturnsOnCall[i] = struct {
+		result1 []glide.Job
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) Pipeline(arg1 context.Context, arg2 string, arg3 string) (glide.Pipeline, error) {
+	fake.pipelineMutex.Lock()
+	ret, specificReturn := fake.pipelineReturnsOnCall[len(fake.pipelineArgsForCall)]
+	fake.pipelineArgsForCall = append(fake.pipelineArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.PipelineStub
+	fakeReturns := fake.pipelineReturns
+	fake.recordInvocation("Pipeline", []interface{}{arg1, arg2, arg3})
+	fake.pipelineMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPI) PipelineCallCount() int {
+	fake.pipelineMutex.RLock()
+	defer fake.pipelineMutex.RUnlock()
+	return len(fake.pipelineArgsForCall)
+}
+
+func (fake *FakeAPI) PipelineCalls(stub func(context.Context, string, string) (glide.Pipeline, error)) {
+	fake.pipelineMutex.Lock()
+	defer fake.pipelineMutex.Unlock()
+	fake.PipelineStub = stub
+}
+
+func (fake *FakeAPI) PipelineArgsForCall(i int) (context.Context, string, string) {
+	fake.pipelineMutex.RLock()
+	defer fake.pipelineMutex.RUnlock()
+	argsForCall := fake.pipelineArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeAPI) PipelineReturns(result1 glide.Pipeline, result2 error) {
+	fake.pipelineMutex.Lock()
+	defer fake.pipelineMutex.Unlock()
+	fake.PipelineStub = nil
+	fake.pipelineReturns = struct {
+		result1 glide.Pipeline
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) PipelineReturnsOnCall(i int, result1 glide.Pipeline, result2 error) {
+	fake.pipelineMutex.Lock()
+	defer fake.pipelineMutex.Unlock()
+	fake.PipelineStub = nil
+	if fake.pipelineReturnsOnCall == nil {
+		fake.pipelineReturnsOnCall = make(map[int]struct {
+			result1 glide.Pipeline
+			result2 error
+		})
+	}
+	fake.pipelineReturnsOnCall[i] = struct {
+		result1 glide.Pipeline
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) PipelineBuilds(arg1 context.Context, arg2 string, arg3 string, arg4 glide.Page) ([]glide.Build, glide.Pagination, error) {
+	fake.pipelineBuildsMutex.Lock()
+	ret, specificReturn := fake.pipelineBuildsReturnsOnCall[len(fake.pipelineBuildsArgsForCall)]
+	fake.pipelineBuildsArgsForCall = append(fake.pipelineBuildsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 glide.Page
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.PipelineBuildsStub
+	fakeReturns := fake.pipelineBuildsReturns
+	fake.recordInvocation("PipelineBuilds", []interface{}{arg1, arg2, arg3, arg4})
+	fake.pipelineBuildsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeAPI) PipelineBuildsCallCount() int {
+	fake.pipelineBuildsMutex.RLock()
+	defer fake.pipelineBuildsMutex.RUnlock()
+	return len(fake.pipelineBuildsArgsForCall)
+}
+
+func (fake *FakeAPI) PipelineBuildsCalls(stub func(context.Context, string, string, glide.Page) ([]glide.Build, glide.Pagination, error)) {
+	fake.pipelineBuildsMutex.Lock()
+	defer fake.pipelineBuildsMutex.Unlock()
+	fake.PipelineBuildsStub = stub
+}
+
+func (fake *FakeAPI) PipelineBuildsArgsForCall(i int) (context.Context, string, string, glide.Page) {
+	fake.pipelineBuildsMutex.RLock()
+	defer fake.pipelineBuildsMutex.RUnlock()
+	argsForCall := fake.pipelineBuildsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeAPI) PipelineBuildsReturns(result1 []glide.Build, result2 glide.Pagination, result3 error) {
+	fake.pipelineBuildsMutex.Lock()
+	defer fake.pipelineBuildsMutex.Unlock()
+	fake.PipelineBuildsStub = nil
+	fake.pipelineBuildsReturns = struct {
+		result1 []glide.Build
+		result2 glide.Pagination
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAPI) PipelineBuildsReturnsOnCall(i int, result1 []glide.Build, result2 glide.Pagination, result3 error) {
+	fake.pipelineBuildsMutex.Lock()
+	defer fake.pipelineBuildsMutex.Unlock()
+	fake.PipelineBuildsStub = nil
+	if fake.pipelineBuildsReturnsOnCall == nil {
+		fake.pipelineBuildsReturnsOnCall = make(map[int]struct {
+			result1 []glide.Build
+			result2 glide.Pagination
+			result3 error
+		})
+	}
+	fake.pipelineBuildsReturnsOnCall[i] = struct {
+		result1 []glide.Build
+		result2 glide.Pagination
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAPI) PipelineConfig(arg1 context.Context, arg2 string, arg3 string) ([]byte, string, error) {
+	fake.pipelineConfigMutex.Lock()
+	ret, specificReturn := fake.pipelineConfigReturnsOnCall[len(fake.pipelineConfigArgsForCall)]
+	fake.pipelineConfigArgsForCall = append(fake.pipelineConfigArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.PipelineConfigStub
+	fakeReturns := fake.pipelineConfigReturns
+	fake.recordInvocation("PipelineConfig", []interface{}{arg1, arg2, arg3})
+	fake.pipelineConfigMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeAPI) PipelineConfigCallCount() int {
+	fake.pipelineConfigMutex.RLock()
+	defer fake.pipelineConfigMutex.RUnlock()
+	return len(fake.pipelineConfigArgsForCall)
+}
+
+func (fake *FakeAPI) PipelineConfigCalls(stub func(context.Context, string, string) ([]byte, string, error)) {
+	fake.pipelineConfigMutex.Lock()
+	defer fake.pipelineConfigMutex.Unlock()
+	fake.PipelineConfigStub = stub
+}
+
+func (fake *FakeAPI) PipelineConfigArgsForCall(i int) (context.Context, string, string) {
+	fake.pipelineConfigMutex.RLock()
+	defer fake.pipelineConfigMutex.RUnlock()
+	argsForCall := fake.pipelineConfigArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeAPI) PipelineConfigReturns(result1 []byte, result2 string, result3 error) {
+	fake.pipelineConfigMutex.Lock()
+	defer fake.pipelineConfigMutex.Unlock()
+	fake.PipelineConfigStub = nil
+	fake.pipelineConfigReturns = struct {
+		result1 []byte
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAPI) PipelineConfigReturnsOnCall(i int, result1 []byte, result2 string, result3 error) {
+	fake.pipelineConfigMutex.Lock()
+	defer fake.pipelineConfigMutex.Unlock()
+	fake.PipelineConfigStub = nil
+	if fake.pipelineConfigReturnsOnCall == nil {
+		fake.pipelineConfigReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 string
+			result3 error
+		})
+	}
+	fake.pipelineConfigReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAPI) Pipelines(arg1 context.Context, arg2 string) ([]glide.Pipeline, error) {
+	fake.pipelinesMutex.Lock()
+	ret, specificReturn := fake.pipelinesReturnsOnCall[len(fake.pipelinesArgsForCall)]
+	fake.pipelinesArgsForCall = append(fake.pipelinesArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.PipelinesStub
+	fakeReturns := fake.pipelinesReturns
+	fake.recordInvocation("Pipelines", []interface{}{arg1, arg2})
+	fake.pipelinesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPI) PipelinesCallCount() int {
+	fake.pipelinesMutex.RLock()
+	defer fake.pipelinesMutex.RUnlock()
+	return len(fake.pipelinesArgsForCall)
+}
+
+func (fake *FakeAPI) PipelinesCalls(stub func(context.Context, string) ([]glide.Pipeline, error)) {
+	fake.pipelinesMutex.Lock()
+	defer fake.pipelinesMutex.Unlock()
+	fake.PipelinesStub = stub
+}
+
+func (fake *FakeAPI) PipelinesArgsForCall(i int) (context.Context, string) {
+	fake.pipelinesMutex.RLock()
+	defer fake.pipelinesMutex.RUnlock()
+	argsForCall := fake.pipelinesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAPI) PipelinesReturns(result1 []glide.Pipeline, result2 error) {
+	fake.pipelinesMutex.Lock()
+	defer fake.pipelinesMutex.Unlock()
+	fake.PipelinesStub = nil
+	fake.pipelinesReturns = struct {
+		result1 []glide.Pipeline
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) PipelinesReturnsOnCall(i int, result1 []glide.Pipeline, result2 error) {
+	fake.pipelinesMutex.Lock()
+	defer fake.pipelinesMutex.Unlock()
+	fake.PipelinesStub = nil
+	if fake.pipelinesReturnsOnCall == nil {
+		fake.pipelinesReturnsOnCall = make(map[int]struct {
+			result1 []glide.Pipeline
+			result2 error
+		})
+	}
+	fake.pipelinesReturnsOnCall[i] = struct {
+		result1 []glide.Pipeline
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) Resource(arg1 context.Context, arg2 string, arg3 string, arg4 string) (glide.Resource, error) {
+	fake.resourceMutex.Lock()
+	ret, specificReturn := fake.resourceReturnsOnCall[len(fake.resourceArgsForCall)]
+	fake.resourceArgsForCall = append(fake.resourceArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ResourceStub
+	fakeReturns := fake.resourceReturns
+	fake.recordInvocation("Resource", []interface{}{arg1, arg2, arg3, arg4})
+	fake.resourceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPI) ResourceCallCount() int {
+	fake.resourceMutex.RLock()
+	defer fake.resourceMutex.RUnlock()
+	return len(fake.resourceArgsForCall)
+}
+
+func (fake *FakeAPI) ResourceCalls(stub func(context.Context, string, string, string) (glide.Resource, error)) {
+	fake.resourceMutex.Lock()
+	defer fake.resourceMutex.Unlock()
+	fake.ResourceStub = stub
+}
+
+func (fake *FakeAPI) ResourceArgsForCall(i int) (context.Context, string, string, string) {
+	fake.resourceMutex.RLock()
+	defer fake.resourceMutex.RUnlock()
+	argsForCall := fake.resourceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeAPI) ResourceReturns(result1 glide.Resource, result2 error) {
+	fake.resourceMutex.Lock()
+	defer fake.resourceMutex.Unlock()
+	fake.ResourceStub = nil
+	fake.resourceReturns = struct {
+		result1 glide.Resource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) ResourceReturnsOnCall(i int, result1 glide.Resource, result2 error) {
+	fake.resourceMutex.Lock()
+	defer fake.resourceMutex.Unlock()
+	fake.ResourceStub = nil
+	if fake.resourceReturnsOnCall == nil {
+		fake.resourceReturnsOnCall = make(map[int]struct {
+			result1 glide.Resource
+			result2 error
+		})
+	}
+	fake.resourceReturnsOnCall[i] = struct {
+		result1 glide.Resource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) ResourceTypes(arg1 context.Context, arg2 string, arg3 string) ([]glide.ResourceType, error) {
+	fake.resourceTypesMutex.Lock()
+	ret, specificReturn := fake.resourceTypesReturnsOnCall[len(fake.resourceTypesArgsForCall)]
+	fake.resourceTypesArgsForCall = append(fake.resourceTypesArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ResourceTypesStub
+	fakeReturns := fake.resourceTypesReturns
+	fake.recordInvocation("ResourceTypes", []interface{}{arg1, arg2, arg3})
+	fake.resourceTypesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPI) ResourceTypesCallCount() int {
+	fake.resourceTypesMutex.RLock()
+	defer fake.resourceTypesMutex.RUnlock()
+	return len(fake.resourceTypesArgsForCall)
+}
+
+func (fake *FakeAPI) ResourceTypesCalls(stub func(context.Context, string, string) ([]glide.ResourceType, error)) {
+	fake.resourceTypesMutex.Lock()
+	defer fake.resourceTypesMutex.Unlock()
+	fake.ResourceTypesStub = stub
+}
+
+func (fake *FakeAPI) ResourceTypesArgsForCall(i int) (context.Context, string, string) {
+	fake.resourceTypesMutex.RLock()
+	defer fake.resourceTypesMutex.RUnlock()
+	argsForCall := fake.resourceTypesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeAPI) ResourceTypesReturns(result1 []glide.ResourceType, result2 error) {
+	fake.resourceTypesMutex.Lock()
+	defer fake.resourceTypesMutex.Unlock()
+	fake.ResourceTypesStub = nil
+	fake.resourceTypesReturns = struct {
+		result1 []glide.ResourceType
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) ResourceTypesReturnsOnCall(i int, result1 []glide.ResourceType, result2 error) {
+	fake.resourceTypesMutex.Lock()
+	defer fake.resourceTypesMutex.Unlock()
+	fake.ResourceTypesStub = nil
+	if fake.resourceTypesReturnsOnCall == nil {
+		fake.resourceTypesReturnsOnCall = make(map[int]struct {
+			result1 []glide.ResourceType
+			result2 error
+		})
+	}
+	fake.resourceTypesReturnsOnCall[i] = struct {
+		result1 []glide.ResourceType
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) ResourceVersionCausality(arg1 context.Context, arg2 string, arg3 string, arg4 string, arg5 int) (glide.Causality, error) {
+	fake.resourceVersionCausalityMutex.Lock()
+	ret, specificReturn := fake.resourceVersionCausalityReturnsOnCall[len(fake.resourceVersionCausalityArgsForCall)]
+	fake.resourceVersionCausalityArgsForCall = append(fake.resourceVersionCausalityArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 int
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.ResourceVersionCausalityStub
+	fakeReturns := fake.resourceVersionCausalityReturns
+	fake.recordInvocation("ResourceVersionCausality", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.resourceVersionCausalityMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPI) ResourceVersionCausalityCallCount() int {
+	fake.resourceVersionCausalityMutex.RLock()
+	defer fake.resourceVersionCausalityMutex.RUnlock()
+	return len(fake.resourceVersionCausalityArgsForCall)
+}
+
+func (fake *FakeAPI) ResourceVersionCausalityCalls(stub func(context.Context, string, string, string, int) (glide.Causality, error)) {
+	fake.resourceVersionCausalityMutex.Lock()
+	defer fake.resourceVersionCausalityMutex.Unlock()
+	fake.ResourceVersionCausalityStub = stub
+}
+
+func (fake *FakeAPI) ResourceVersionCausalityArgsForCall(i int) (context.Context, string, string, string, int) {
+	fake.resourceVersionCausalityMutex.RLock()
+	defer fake.resourceVersionCausalityMutex.RUnlock()
+	argsForCall := fake.resourceVersionCausalityArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeAPI) ResourceVersionCausalityReturns(result1 glide.Causality, result2 error) {
+	fake.resourceVersionCausalityMutex.Lock()
+	defer fake.resourceVersionCausalityMutex.Unlock()
+	fake.ResourceVersionCausalityStub = nil
+	fake.resourceVersionCausalityReturns = struct {
+		result1 glide.Causality
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) ResourceVersionCausalityReturnsOnCall(i int, result1 glide.Causality, result2 error) {
+	fake.resourceVersionCausalityMutex.Lock()
+	defer fake.resourceVersionCausalityMutex.Unlock()
+	fake.ResourceVersionCausalityStub = nil
+	if fake.resourceVersionCausalityReturnsOnCall == nil {
+		fake.resourceVersionCausalityReturnsOnCall = make(map[int]struct {
+			result1 glide.Causality
+			result2 error
+		})
+	}
+	fake.resourceVersionCausalityReturnsOnCall[i] = struct {
+		result1 glide.Causality
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) ResourceVersions(arg1 context.Context, arg2 string, arg3 string, arg4 string) ([]glide.ResourceVersion, error) {
+	fake.resourceVersionsMutex.Lock()
+	ret, specificReturn := fake.resourceVersionsReturnsOnCall[len(fake.resourceVersionsArgsForCall)]
+	fake.resourceVersionsArgsForCall = append(fake.resourceVersionsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ResourceVersionsStub
+	fakeReturns := fake.resourceVersionsReturns
+	fake.recordInvocation("ResourceVersions", []interface{}{arg1, arg2, arg3, arg4})
+	fake.resourceVersionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPI) ResourceVersionsCallCount() int {
+	fake.resourceVersionsMutex.RLock()
+	defer fake.resourceVersionsMutex.RUnlock()
+	return len(fake.resourceVersionsArgsForCall)
+}
+
+func (fake *FakeAPI) ResourceVersionsCalls(stub func(context.Context, string, string, string) ([]glide.ResourceVersion, error)) {
+	fake.resourceVersionsMutex.Lock()
+	defer fake.resourceVersionsMutex.Unlock()
+	fake.ResourceVersionsStub = stub
+}
+
+func (fake *FakeAPI) ResourceVersionsArgsForCall(i int) (context.Context, string, string, string) {
+	fake.resourceVersionsMutex.RLock()
+	defer fake.resourceVersionsMutex.RUnlock()
+	argsForCall := fake.resourceVersionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeAPI) ResourceVersionsReturns(result1 []glide.ResourceVersion, result2 error) {
+	fake.resourceVersionsMutex.Lock()
+	defer fake.resourceVersionsMutex.Unlock()
+	fake.ResourceVersionsStub = nil
+	fake.resourceVersionsReturns = struct {
+		result1 []glide.ResourceVersion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) ResourceVersionsReturnsOnCall(i int, result1 []glide.ResourceVersion, result2 error) {
+	fake.resourceVersionsMutex.Lock()
+	defer fake.resourceVersionsMutex.Unlock()
+	fake.ResourceVersionsStub = nil
+	if fake.resourceVersionsReturnsOnCall == nil {
+		fake.resourceVersionsReturnsOnCall = make(map[int]struct {
+			result1 []glide.ResourceVersion
+			result2 error
+		})
+	}
+	fake.resourceVersionsReturnsOnCall[i] = struct {
+		result1 []glide.ResourceVersion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) ResourceVersionsPage(arg1 context.Context, arg2 string, arg3 string, arg4 string, arg5 glide.Page) ([]glide.ResourceVersion, glide.Pagination, error) {
+	fake.resourceVersionsPageMutex.Lock()
+	ret, specificReturn := fake.resourceVersionsPageReturnsOnCall[len(fake.resourceVersionsPageArgsForCall)]
+	fake.resourceVersionsPageArgsForCall = append(fake.resourceVersionsPageArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 glide.Page
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.ResourceVersionsPageStub
+	fakeReturns := fake.resourceVersionsPageReturns
+	fake.recordInvocation("ResourceVersionsPage", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.resourceVersionsPageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeAPI) ResourceVersionsPageCallCount() int {
+	fake.resourceVersionsPageMutex.RLock()
+	defer fake.resourceVersionsPageMutex.RUnlock()
+	return len(fake.resourceVersionsPageArgsForCall)
+}
+
+func (fake *FakeAPI) ResourceVersionsPageCalls(stub func(context.Context, string, string, string, glide.Page) ([]glide.ResourceVersion, glide.Pagination, error)) {
+	fake.resourceVersionsPageMutex.Lock()
+	defer fake.resourceVersionsPageMutex.Unlock()
+	fake.ResourceVersionsPageStub = stub
+}
+
+func (fake *FakeAPI) ResourceVersionsPageArgsForCall(i int) (context.Context, string, string, string, glide.Page) {
+	fake.resourceVersionsPageMutex.RLock()
+	defer fake.resourceVersionsPageMutex.RUnlock()
+	argsForCall := fake.resourceVersionsPageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeAPI) ResourceVersionsPageReturns(result1 []glide.ResourceVersion, result2 glide.Pagination, result3 error) {
+	fake.resourceVersionsPageMutex.Lock()
+	defer fake.resourceVersionsPageMutex.Unlock()
+	fake.ResourceVersionsPageStub = nil
+	fake.resourceVersionsPageReturns = struct {
+		result1 []glide.ResourceVersion
+		result2 glide.Pagination
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAPI) ResourceVersionsPageReturnsOnCall(i int, result1 []glide.ResourceVersion, result2 glide.Pagination, result3 error) {
+	fake.resourceVersionsPageMutex.Lock()
+	defer fake.resourceVersionsPageMutex.Unlock()
+	fake.ResourceVersionsPageStub = nil
+	if fake.resourceVersionsPageReturnsOnCall == nil {
+		fake.resourceVersionsPageReturnsOnCall = make(map[int]struct {
+			result1 []glide.ResourceVersion
+			result2 glide.Pagination
+			result3 error
+		})
+	}
+	fake.resourceVersionsPageReturnsOnCall[i] = struct {
+		result1 []glide.ResourceVersion
+		result2 glide.Pagination
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAPI) Resources(arg1 context.Context, arg2 string, arg3 string) ([]glide.Resource, error) {
+	fake.resourcesMutex.Lock()
+	ret, specificReturn := fake.resourcesReturnsOnCall[len(fake.resourcesArgsForCall)]
+	fake.resourcesArgsForCall = append(fake.resourcesArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ResourcesStub
+	fakeReturns := fake.resourcesReturns
+	fake.recordInvocation("Resources", []interface{}{arg1, arg2, arg3})
+	fake.resourcesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPI) ResourcesCallCount() int {
+	fake.resourcesMutex.RLock()
+	defer fake.resourcesMutex.RUnlock()
+	return len(fake.resourcesArgsForCall)
+}
+
+func (fake *FakeAPI) ResourcesCalls(stub func(context.Context, string, string) ([]glide.Resource, error)) {
+	fake.resourcesMutex.Lock()
+	defer fake.resourcesMutex.Unlock()
+	fake.ResourcesStub = stub
+}
+
+func (fake *FakeAPI) ResourcesArgsForCall(i int) (context.Context, string, string) {
+	fake.resourcesMutex.RLock()
+	defer fake.resourcesMutex.RUnlock()
+	argsForCall := fake.resourcesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeAPI) ResourcesReturns(result1 []glide.Resource, result2 error) {
+	fake.resourcesMutex.Lock()
+	defer fake.resourcesMutex.Unlock()
+	fake.ResourcesStub = nil
+	fake.resourcesReturns = struct {
+		result1 []glide.Resource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) ResourcesReturnsOnCall(i int, result1 []glide.Resource, result2 error) {
+	fake.resourcesMutex.Lock()
+	defer fake.resourcesMutex.Unlock()
+	fake.ResourcesStub = nil
+	if fake.resourcesReturnsOnCall == nil {
+		fake.resourcesReturnsOnCall = make(map[int]struct {
+			result1 []glide.Resource
+			result2 error
+		})
+	}
+	fake.resourcesReturnsOnCall[i] = struct {
+		result1 []glide.Resource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) TeamBuilds(arg1 context.Context, arg2 string, arg3 glide.Page) ([]glide.Build, glide.Pagination, error) {
+	fake.teamBuildsMutex.Lock()
+	ret, specificReturn := fake.teamBuildsReturnsOnCall[len(fake.teamBuildsArgsForCall)]
+	fake.teamBuildsArgsForCall = append(fake.teamBuildsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 glide.Page
+	}{arg1, arg2, arg3})
+	stub := fake.TeamBuildsStub
+	fakeReturns := fake.teamBuildsReturns
+	fake.recordInvocation("TeamBuilds", []interface{}{arg1, arg2, arg3})
+	fake.teamBuildsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeAPI) TeamBuildsCallCount() int {
+	fake.teamBuildsMutex.RLock()
+	defer fake.teamBuildsMutex.RUnlock()
+	return len(fake.teamBuildsArgsForCall)
+}
+
+func (fake *FakeAPI) TeamBuildsCalls(stub func(context.Context, string, glide.Page) ([]glide.Build, glide.Pagination, error)) {
+	fake.teamBuildsMutex.Lock()
+	defer fake.teamBuildsMutex.Unlock()
+	fake.TeamBuildsStub = stub
+}
+
+func (fake *FakeAPI) TeamBuildsArgsForCall(i int) (context.Context, string, glide.Page) {
+	fake.teamBuildsMutex.RLock()
+	defer fake.teamBuildsMutex.RUnlock()
+	argsForCall := fake.teamBuildsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeAPI) TeamBuildsReturns(result1 []glide.Build, result2 glide.Pagination, result3 error) {
+	fake.teamBuildsMutex.Lock()
+	defer fake.teamBuildsMutex.Unlock()
+	fake.TeamBuildsStub = nil
+	fake.teamBuildsReturns = struct {
+		result1 []glide.Build
+		result2 glide.Pagination
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAPI) TeamBuildsReturnsOnCall(i int, result1 []glide.Build, result2 glide.Pagination, result3 error) {
+	fake.teamBuildsMutex.Lock()
+	defer fake.teamBuildsMutex.Unlock()
+	fake.TeamBuildsStub = nil
+	if fake.teamBuildsReturnsOnCall == nil {
+		fake.teamBuildsReturnsOnCall = make(map[int]struct {
+			result1 []glide.Build
+			result2 glide.Pagination
+			result3 error
+		})
+	}
+	fake.teamBuildsReturnsOnCall[i] = struct {
+		result1 []glide.Build
+		result2 glide.Pagination
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAPI) TeamJobs(arg1 context.Context, arg2 string) ([]glide.Job, error) {
+	fake.teamJobsMutex.Lock()
+	ret, specificReturn := fake.teamJobsReturnsOnCall[len(fake.teamJobsArgsForCall)]
+	fake.teamJobsArgsForCall = append(fake.teamJobsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.TeamJobsStub
+	fakeReturns := fake.teamJobsReturns
+	fake.recordInvocation("TeamJobs", []interface{}{arg1, arg2})
+	fake.teamJobsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPI) TeamJobsCallCount() int {
+	fake.teamJobsMutex.RLock()
+	defer fake.teamJobsMutex.RUnlock()
+	return len(fake.teamJobsArgsForCall)
+}
+
+func (fake *FakeAPI) TeamJobsCalls(stub func(context.Context, string) ([]glide.Job, error)) {
+	fake.teamJobsMutex.Lock()
+	defer fake.teamJobsMutex.Unlock()
+	fake.TeamJobsStub = stub
+}
+
+func (fake *FakeAPI) TeamJobsArgsForCall(i int) (context.Context, string) {
+	fake.teamJobsMutex.RLock()
+	defer fake.teamJobsMutex.RUnlock()
+	argsForCall := fake.teamJobsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAPI) TeamJobsReturns(result1 []glide.Job, result2 error) {
+	fake.teamJobsMutex.Lock()
+	defer fake.teamJobsMutex.Unlock()
+	fake.TeamJobsStub = nil
+	fake.teamJobsReturns = struct {
+		result1 []glide.Job
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) TeamJobsReturnsOnCall(i int, result1 []glide.Job, result2 error) {
+	fake.teamJobsMutex.Lock()
+	defer fake.teamJobsMutex.Unlock()
+	fake.TeamJobsStub = nil
+	if fake.teamJobsReturnsOnCall == nil {
+		fake.teamJobsReturnsOnCall = make(map[int]struct {
+			result1 []glide.Job
+			result2 error
+		})
+	}
+	fake.teamJobsReturnsOnCall[i] = struct {
+		result1 []glide.Job
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) Teams(arg1 context.Context) ([]glide.Team, error) {
+	fake.teamsMutex.Lock()
+	ret, specificReturn := fake.teamsReturnsOnCall[len(fake.teamsArgsForCall)]
+	fake.teamsArgsForCall = append(fake.teamsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.TeamsStub
+	fakeReturns := fake.teamsReturns
+	fake.recordInvocation("Teams", []interface{}{arg1})
+	fake.teamsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPI) TeamsCallCount() int {
+	fake.teamsMutex.RLock()
+	defer fake.teamsMutex.RUnlock()
+	return len(fake.teamsArgsForCall)
+}
+
+func (fake *FakeAPI) TeamsCalls(stub func(context.Context) ([]glide.Team, error)) {
+	fake.teamsMutex.Lock()
+	defer fake.teamsMutex.Unlock()
+	fake.TeamsStub = stub
+}
+
+func (fake *FakeAPI) TeamsArgsForCall(i int) context.Context {
+	fake.teamsMutex.RLock()
+	defer fake.teamsMutex.RUnlock()
+	argsForCall := fake.teamsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAPI) TeamsReturns(result1 []glide.Team, result2 error) {
+	fake.teamsMutex.Lock()
+	defer fake.teamsMutex.Unlock()
+	fake.TeamsStub = nil
+	fake.teamsReturns = struct {
+		result1 []glide.Team
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) TeamsReturnsOnCall(i int, result1 []glide.Team, result2 error) {
+	fake.teamsMutex.Lock()
+	defer fake.teamsMutex.Unlock()
+	fake.TeamsStub = nil
+	if fake.teamsReturnsOnCall == nil {
+		fake.teamsReturnsOnCall = make(map[int]struct {
+			result1 []glide.Team
+			result2 error
+		})
+	}
+	fake.teamsReturnsOnCall[i] = struct {
+		result1 []glide.Team
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) UserInfo(arg1 context.Context) (glide.UserInfo, error) {
+	fake.userInfoMutex.Lock()
+	ret, specificReturn := fake.userInfoReturnsOnCall[len(fake.userInfoArgsForCall)]
+	fake.userInfoArgsForCall = append(fake.userInfoArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.UserInfoStub
+	fakeReturns := fake.userInfoReturns
+	fake.recordInvocation("UserInfo", []interface{}{arg1})
+	fake.userInfoMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPI) UserInfoCallCount() int {
+	fake.userInfoMutex.RLock()
+	defer fake.userInfoMutex.RUnlock()
+	return len(fake.userInfoArgsForCall)
+}
+
+func (fake *FakeAPI) UserInfoCalls(stub func(context.Context) (glide.UserInfo, error)) {
+	fake.userInfoMutex.Lock()
+	defer fake.userInfoMutex.Unlock()
+	fake.UserInfoStub = stub
+}
+
+func (fake *FakeAPI) UserInfoArgsForCall(i int) context.Context {
+	fake.userInfoMutex.RLock()
+	defer fake.userInfoMutex.RUnlock()
+	argsForCall := fake.userInfoArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAPI) UserInfoReturns(result1 glide.UserInfo, result2 error) {
+	fake.userInfoMutex.Lock()
+	defer fake.userInfoMutex.Unlock()
+	fake.UserInfoStub = nil
+	fake.userInfoReturns = struct {
+		result1 glide.UserInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) UserInfoReturnsOnCall(i int, result1 glide.UserInfo, result2 error) {
+	fake.userInfoMutex.Lock()
+	defer fake.userInfoMutex.Unlock()
+	fake.UserInfoStub = nil
+	if fake.userInfoReturnsOnCall == nil {
+		fake.userInfoReturnsOnCall = make(map[int]struct {
+			result1 glide.UserInfo
+			result2 error
+		})
+	}
+	fake.userInfoReturnsOnCall[i] = struct {
+		result1 glide.UserInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) Volumes(arg1 context.Context, arg2 string) ([]glide.Volume, error) {
+	fake.volumesMutex.Lock()
+	ret, specificReturn := fake.volumesReturnsOnCall[len(fake.volumesArgsForCall)]
+	fake.volumesArgsForCall = append(fake.volumesArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.VolumesStub
+	fakeReturns := fake.volumesReturns
+	fake.recordInvocation("Volumes", []interface{}{arg1, arg2})
+	fake.volumesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPI) VolumesCallCount() int {
+	fake.volumesMutex.RLock()
+	defer fake.volumesMutex.RUnlock()
+	return len(fake.volumesArgsForCall)
+}
+
+func (fake *FakeAPI) VolumesCalls(stub func(context.Context, string) ([]glide.Volume, error)) {
+	fake.volumesMutex.Lock()
+	defer fake.volumesMutex.Unlock()
+	fake.VolumesStub = stub
+}
+
+func (fake *FakeAPI) VolumesArgsForCall(i int) (context.Context, string) {
+	fake.volumesMutex.RLock()
+	defer fake.volumesMutex.RUnlock()
+	argsForCall := fake.volumesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAPI) VolumesReturns(result1 []glide.Volume, result2 error) {
+	fake.volumesMutex.Lock()
+	defer fake.volumesMutex.Unlock()
+	fake.VolumesStub = nil
+	fake.volumesReturns = struct {
+		result1 []glide.Volume
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) VolumesReturnsOnCall(i int, result1 []glide.Volume, result2 error) {
+	fake.volumesMutex.Lock()
+	defer fake.volumesMutex.Unlock()
+	fake.VolumesStub = nil
+	if fake.volumesReturnsOnCall == nil {
+		fake.volumesReturnsOnCall = make(map[int]struct {
+			result1 []glide.Volume
+			result2 error
+		})
+	}
+	fake.volumesReturnsOnCall[i] = struct {
+		result1 []glide.Volume
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) Wall(arg1 context.Context) (glide.Wall, error) {
+	fake.wallMutex.Lock()
+	ret, specificReturn := fake.wallReturnsOnCall[len(fake.wallArgsForCall)]
+	fake.wallArgsForCall = append(fake.wallArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.WallStub
+	fakeReturns := fake.wallReturns
+	fake.recordInvocation("Wall", []interface{}{arg1})
+	fake.wallMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPI) WallCallCount() int {
+	fake.wallMutex.RLock()
+	defer fake.wallMutex.RUnlock()
+	return len(fake.wallArgsForCall)
+}
+
+func (fake *FakeAPI) WallCalls(stub func(context.Context) (glide.Wall, error)) {
+	fake.wallMutex.Lock()
+	defer fake.wallMutex.Unlock()
+	fake.WallStub = stub
+}
+
+func (fake *FakeAPI) WallArgsForCall(i int) context.Context {
+	fake.wallMutex.RLock()
+	defer fake.wallMutex.RUnlock()
+	argsForCall := fake.wallArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAPI) WallReturns(result1 glide.Wall, result2 error) {
+	fake.wallMutex.Lock()
+	defer fake.wallMutex.Unlock()
+	fake.WallStub = nil
+	fake.wallReturns = struct {
+		result1 glide.Wall
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) WallReturnsOnCall(i int, result1 glide.Wall, result2 error) {
+	fake.wallMutex.Lock()
+	defer fake.wallMutex.Unlock()
+	fake.WallStub = nil
+	if fake.wallReturnsOnCall == nil {
+		fake.wallReturnsOnCall = make(map[int]struct {
+			result1 glide.Wall
+			result2 error
+		})
+	}
+	fake.wallReturnsOnCall[i] = struct {
+		result1 glide.Wall
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) Workers(arg1 context.Context) ([]glide.Worker, error) {
+	fake.workersMutex.Lock()
+	ret, specificReturn := fake.workersReturnsOnCall[len(fake.workersArgsForCall)]
+	fake.workersArgsForCall = append(fake.workersArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.WorkersStub
+	fakeReturns := fake.workersReturns
+	fake.recordInvocation("Workers", []interface{}{arg1})
+	fake.workersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPI) WorkersCallCount() int {
+	fake.workersMutex.RLock()
+	defer fake.workersMutex.RUnlock()
+	return len(fake.workersArgsForCall)
+}
+
+func (fake *FakeAPI) WorkersCalls(stub func(context.Context) ([]glide.Worker, error)) {
+	fake.workersMutex.Lock()
+	defer fake.workersMutex.Unlock()
+	fake.WorkersStub = stub
+}
+
+func (fake *FakeAPI) WorkersArgsForCall(i int) context.Context {
+	fake.workersMutex.RLock()
+	defer fake.workersMutex.RUnlock()
+	argsForCall := fake.workersArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAPI) WorkersReturns(result1 []glide.Worker, result2 error) {
+	fake.workersMutex.Lock()
+	defer fake.workersMutex.Unlock()
+	fake.WorkersStub = nil
+	fake.workersReturns = struct {
+		result1 []glide.Worker
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) WorkersReturnsOnCall(i int, result1 []glide.Worker, result2 error) {
+	fake.workersMutex.Lock()
+	defer fake.workersMutex.Unlock()
+	fake.WorkersStub = nil
+	if fake.workersReturnsOnCall == nil {
+		fake.workersReturnsOnCall = make(map[int]struct {
+			result1 []glide.Worker
+			result2 error
+		})
+	}
+	fake.workersReturnsOnCall[i] = struct {
+		result1 []glide.Worker
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPI) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeAPI) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ glide.API = new(FakeAPI)
@@ -0,0 +1,240 @@
+// Package glidetest provides an in-memory Concourse ATC for testing code
+// that uses a glide.Client.
+package glidetest
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/crhntr/glide"
+)
+
+// Token is the bearer token issued and accepted by the Server.
+const Token = "glidetest-token"
+
+// Version is the ATC version returned by the info endpoint.
+const Version = "7.11.2"
+
+// Server is a fake ATC serving the read endpoints for teams, pipelines,
+// jobs, builds, and build events from fixtures added with the Add methods.
+// Requests to the API must use Token.
+type Server struct {
+	URL string
+
+	server *httptest.Server
+
+	mu        sync.Mutex
+	teams     []glide.Team
+	pipelines []glide.Pipeline
+	jobs      []glide.Job
+	builds    []glide.Build
+	events    map[int][]glide.BuildEvent
+}
+
+// NewServer starts a Server and returns it with a Client configured to use
+// it. The Server is closed when the test finishes.
+func NewServer(t testing.TB) (*Server, *glide.Client) {
+	t.Helper()
+	server := &Server{
+		events: make(map[int][]glide.BuildEvent),
+	}
+	server.server = httptest.NewServer(server.handler())
+	server.URL = server.server.URL
+	t.Cleanup(server.Close)
+	return server, glide.NewClient(glide.WithURL(server.URL), glide.WithToken(Token))
+}
+
+func (server *Server) Close() {
+	server.server.Close()
+}
+
+// AddTeam adds a team. When team.ID is zero the next ID is assigned.
+func (server *Server) AddTeam(team glide.Team) glide.Team {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	if team.ID == 0 {
+		team.ID = len(server.teams) + 1
+	}
+	server.teams = append(server.teams, team)
+	return team
+}
+
+// AddPipeline adds a pipeline to the team named by pipeline.TeamName. When
+// pipeline.ID is zero the next ID is assigned.
+func (server *Server) AddPipeline(pipeline glide.Pipeline) glide.Pipeline {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	if pipeline.ID == 0 {
+		pipeline.ID = len(server.pipelines) + 1
+	}
+	server.pipelines = append(server.pipelines, pipeline)
+	return pipeline
+}
+
+// AddJob adds a job to the pipeline named by job.TeamName and
+// job.PipelineName. When job.ID is zero the next ID is assigned.
+func (server *Server) AddJob(job glide.Job) glide.Job {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	if job.ID == 0 {
+		job.ID = len(server.jobs) + 1
+	}
+	server.jobs = append(server.jobs, job)
+	return job
+}
+
+// AddBuild adds a build. Builds of a job set TeamName, PipelineName, and
+// JobName. When build.ID is zero the next ID is assigned.
+func (server *Server) AddBuild(build glide.Build) glide.Build {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	if build.ID == 0 {
+		build.ID = len(server.builds) + 1
+	}
+	server.builds = append(server.builds, build)
+	return build
+}
+
+// SetBuildEvents sets the events streamed for a build. The stream ends after
+// the last event.
+func (server *Server) SetBuildEvents(buildID int, events ...glide.BuildEvent) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	server.events[buildID] = events
+}
+
+func (server *Server) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /sky/issuer/token", func(res http.ResponseWriter, _ *http.Request) {
+		writeJSON(res, map[string]any{
+			"access_token": Token,
+			"token_type":   "bearer",
+			"expires_in":   3600,
+		})
+	})
+	mux.HandleFunc("GET /api/v1/info", func(res http.ResponseWriter, _ *http.Request) {
+		writeJSON(res, glide.Info{Version: Version, WorkerVersion: "2.5"})
+	})
+	api := http.NewServeMux()
+	api.HandleFunc("GET /api/v1/teams", func(res http.ResponseWriter, _ *http.Request) {
+		writeJSON(res, filter(server, &server.teams, func(glide.Team) bool { return true }))
+	})
+	api.HandleFunc("GET /api/v1/pipelines", func(res http.ResponseWriter, _ *http.Request) {
+		writeJSON(res, filter(server, &server.pipelines, func(glide.Pipeline) bool { return true }))
+	})
+	api.HandleFunc("GET /api/v1/teams/{team}/pipelines", func(res http.ResponseWriter, req *http.Request) {
+		writeJSON(res, filter(server, &server.pipelines, func(pipeline glide.Pipeline) bool {
+			return pipeline.TeamName == req.PathValue("team")
+		}))
+	})
+	api.HandleFunc("GET /api/v1/teams/{team}/pipelines/{pipeline}", func(res http.ResponseWriter, req *http.Request) {
+		writeFirst(res, filter(server, &server.pipelines, func(pipeline glide.Pipeline) bool {
+			return pipeline.TeamName == req.PathValue("team") && pipeline.Name == req.PathValue("pipeline")
+		}))
+	})
+	api.HandleFunc("GET /api/v1/jobs", func(res http.ResponseWriter, _ *http.Request) {
+		writeJSON(res, filter(server, &server.jobs, func(glide.Job) bool { return true }))
+	})
+	api.HandleFunc("GET /api/v1/teams/{team}/pipelines/{pipeline}/jobs", func(res http.ResponseWriter, req *http.Request) {
+		writeJSON(res, filter(server, &server.jobs, func(job glide.Job) bool {
+			return job.TeamName == req.PathValue("team") && job.PipelineName == req.PathValue("pipeline")
+		}))
+	})
+	api.HandleFunc("GET /api/v1/teams/{team}/pipelines/{pipeline}/jobs/{job}", func(res http.ResponseWriter, req *http.Request) {
+		writeFirst(res, filter(server, &server.jobs, func(job glide.Job) bool {
+			return job.TeamName == req.PathValue("team") && job.PipelineName == req.PathValue("pipeline") && job.Name == req.PathValue("job")
+		}))
+	})
+	api.HandleFunc("GET /api/v1/teams/{team}/pipelines/{pipeline}/jobs/{job}/builds", func(res http.ResponseWriter, req *http.Request) {
+		writeJSON(res, server.filterBuilds(func(build glide.Build) bool {
+			return build.TeamName == req.PathValue("team") && build.PipelineName == req.PathValue("pipeline") && build.JobName == req.PathValue("job")
+		}))
+	})
+	api.HandleFunc("GET /api/v1/builds", func(res http.ResponseWriter, _ *http.Request) {
+		writeJSON(res, server.filterBuilds(func(glide.Build) bool { return true }))
+	})
+	api.HandleFunc("GET /api/v1/builds/{build}", func(res http.ResponseWriter, req *http.Request) {
+		writeFirst(res, server.filterBuilds(func(build glide.Build) bool {
+			return strconv.Itoa(build.ID) == req.PathValue("build")
+		}))
+	})
+	api.HandleFunc("GET /api/v1/builds/{build}/events", server.writeBuildEvents)
+	mux.Handle("/api/v1/", requireToken(api))
+	return mux
+}
+
+func requireToken(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Authorization") != "Bearer "+Token {
+			res.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(res, req)
+	})
+}
+
+// filterBuilds returns the matching builds newest first like the ATC.
+func (server *Server) filterBuilds(keep func(glide.Build) bool) []glide.Build {
+	builds := filter(server, &server.builds, keep)
+	slices.SortFunc(builds, func(a, b glide.Build) int {
+		return cmp.Compare(b.ID, a.ID)
+	})
+	return builds
+}
+
+func (server *Server) writeBuildEvents(res http.ResponseWriter, req *http.Request) {
+	buildID, err := strconv.Atoi(req.PathValue("build"))
+	if err != nil {
+		res.WriteHeader(http.StatusNotFound)
+		return
+	}
+	server.mu.Lock()
+	events, found := server.events[buildID]
+	server.mu.Unlock()
+	if !found {
+		res.WriteHeader(http.StatusNotFound)
+		return
+	}
+	res.Header().Set("content-type", "text/event-stream")
+	for i, event := range events {
+		data, err := json.Marshal(event)
+		if err != nil {
+			continue
+		}
+		_, _ = fmt.Fprintf(res, "id: %d\nevent: event\ndata: %s\n\n", i, data)
+	}
+	_, _ = io.WriteString(res, "event: end\ndata\n\n")
+}
+
+func filter[T any](server *Server, items *[]T, keep func(T) bool) []T {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	result := make([]T, 0, len(*items))
+	for _, item := range *items {
+		if keep(item) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+func writeFirst[T any](res http.ResponseWriter, items []T) {
+	if len(items) == 0 {
+		res.WriteHeader(http.StatusNotFound)
+		return
+	}
+	writeJSON(res, items[0])
+}
+
+func writeJSON(res http.ResponseWriter, value any) {
+	res.Header().Set("content-type", "application/json")
+	_ = json.NewEncoder(res).Encode(value)
+}
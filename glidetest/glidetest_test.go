@@ -0,0 +1,88 @@
+package glidetest_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/crhntr/glide"
+	"github.com/crhntr/glide/glidetest"
+)
+
+func TestNewServer(t *testing.T) {
+	server, client := glidetest.NewServer(t)
+	server.AddTeam(glide.Team{Name: "main"})
+	server.AddPipeline(glide.Pipeline{Name: "deploy", TeamName: "main"})
+	server.AddPipeline(glide.Pipeline{Name: "other", TeamName: "other"})
+	server.AddJob(glide.Job{Name: "unit", TeamName: "main", PipelineName: "deploy"})
+	build := server.AddBuild(glide.Build{Name: "1", Status: glide.StatusSucceeded, TeamName: "main", PipelineName: "deploy", JobName: "unit"})
+	server.AddBuild(glide.Build{Name: "2", Status: glide.StatusStarted, TeamName: "main", PipelineName: "deploy", JobName: "unit"})
+	server.SetBuildEvents(build.ID, glide.BuildEvent{Event: glide.EventLog, Data: glide.BuildEventData{Payload: "ok\n"}})
+
+	ctx := context.Background()
+
+	info, err := client.Info(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Version != glidetest.Version {
+		t.Errorf("unexpected version: %q", info.Version)
+	}
+
+	teams, err := client.Teams(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(teams) != 1 || teams[0].Name != "main" {
+		t.Errorf("unexpected teams: %#v", teams)
+	}
+
+	pipelines, err := client.Pipelines(ctx, "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pipelines) != 1 || pipelines[0].Name != "deploy" {
+		t.Errorf("unexpected pipelines: %#v", pipelines)
+	}
+	if _, err := client.Pipeline(ctx, "main", "other"); !errors.As(err, new(*glide.NotFoundError)) {
+		t.Errorf("expected not found got: %v", err)
+	}
+
+	job, err := client.Job(ctx, "main", "deploy", "unit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.Name != "unit" {
+		t.Errorf("unexpected job: %#v", job)
+	}
+
+	builds, err := client.JobBuilds(ctx, "main", "deploy", "unit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(builds) != 2 || builds[0].Name != "2" {
+		t.Errorf("expected newest build first: %#v", builds)
+	}
+
+	log, err := client.BuildLog(ctx, build.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = log.Close() }()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(log); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "ok\n" {
+		t.Errorf("unexpected log: %q", out.String())
+	}
+}
+
+func TestServer_unauthorized(t *testing.T) {
+	server, _ := glidetest.NewServer(t)
+	client := glide.NewClient(glide.WithURL(server.URL), glide.WithToken("wrong"))
+	if _, err := client.Teams(context.Background()); !errors.As(err, new(*glide.UnauthorizedError)) {
+		t.Errorf("expected unauthorized got: %v", err)
+	}
+}
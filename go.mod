@@ -1,10 +1,13 @@
 module github.com/crhntr/glide
 
-go 1.21
+go 1.23
 
 require (
 	github.com/vito/go-sse v1.0.0
 	golang.org/x/oauth2 v0.18.0
+	golang.org/x/sync v0.7.0
+	golang.org/x/time v0.5.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
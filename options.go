@@ -0,0 +1,92 @@
+package glide
+
+import (
+	"net/http"
+	"time"
+)
+
+// Option configures a Client created by NewClient.
+type Option func(*Client)
+
+// NewClient creates a Client configured by opts. Fields not set by an option
+// keep the same defaults as the zero Client, including the environment
+// variables read on first use.
+func NewClient(opts ...Option) *Client {
+	client := new(Client)
+	for _, opt := range opts {
+		opt(client)
+	}
+	return client
+}
+
+// WithURL sets Client.URL.
+func WithURL(u string) Option {
+	return func(client *Client) {
+		client.URL = u
+	}
+}
+
+// WithBasicAuth sets the Username and Password used to request a token from
+// the sky issuer.
+func WithBasicAuth(username, password string) Option {
+	return func(client *Client) {
+		client.Username = username
+		client.Password = password
+	}
+}
+
+// WithToken sets Client.BearerToken.
+func WithToken(token string) Option {
+	return func(client *Client) {
+		client.BearerToken = token
+	}
+}
+
+// WithHTTPClient sets the http.Client used to make requests. Its transport
+// is wrapped to add the authorization header. Its Timeout also limits build
+// event streams, so prefer WithTimeout.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(client *Client) {
+		client.Client = *httpClient
+	}
+}
+
+// WithTimeout sets Client.Timeout.
+func WithTimeout(timeout time.Duration) Option {
+	return func(client *Client) {
+		client.Timeout = timeout
+	}
+}
+
+// WithRetryPolicy sets Client.RetryPolicy.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(client *Client) {
+		client.RetryPolicy = policy
+	}
+}
+
+// WithTransport sets the base transport used instead of
+// http.DefaultTransport. The TLS and proxy fields can only be applied when
+// transport is an *http.Transport. To add logging or metrics, use
+// WithMiddleware instead so those fields still apply.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(client *Client) {
+		client.Client.Transport = transport
+	}
+}
+
+// WithMiddleware sets Client.Middleware. The middleware wraps the base
+// transport after the TLS and proxy fields are applied and sees requests as
+// they are sent to the ATC, including the authorization header. When used
+// more than once, later middleware wraps earlier middleware.
+func WithMiddleware(middleware func(http.RoundTripper) http.RoundTripper) Option {
+	return func(client *Client) {
+		if previous := client.Middleware; previous != nil {
+			client.Middleware = func(next http.RoundTripper) http.RoundTripper {
+				return middleware(previous(next))
+			}
+			return
+		}
+		client.Middleware = middleware
+	}
+}
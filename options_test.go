@@ -0,0 +1,97 @@
+package glide_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/crhntr/glide"
+)
+
+type loggingTransport struct {
+	base http.RoundTripper
+}
+
+func (transport loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	res, err := transport.base.RoundTrip(req)
+	if err != nil {
+		log.Printf("%s %s failed after %s: %s", req.Method, req.URL.Path, time.Since(start), err)
+		return nil, err
+	}
+	log.Printf("%s %s %d %s", req.Method, req.URL.Path, res.StatusCode, time.Since(start))
+	return res, nil
+}
+
+func ExampleWithMiddleware() {
+	concourse := glide.NewClient(
+		glide.WithURL("https://ci.example.com"),
+		glide.WithMiddleware(func(next http.RoundTripper) http.RoundTripper {
+			return loggingTransport{base: next}
+		}),
+	)
+	teams, err := concourse.Teams(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, team := range teams {
+		log.Println(team.Name)
+	}
+}
+
+func TestWithMiddleware(t *testing.T) {
+	var order []string
+	record := func(name string) func(http.RoundTripper) http.RoundTripper {
+		return func(next http.RoundTripper) http.RoundTripper {
+			return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				order = append(order, name)
+				return next.RoundTrip(req)
+			})
+		}
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		_, _ = io.WriteString(res, `{"version":"7.11.2"}`)
+	}))
+	defer server.Close()
+	concourse := glide.NewClient(
+		glide.WithURL(server.URL),
+		glide.WithMiddleware(record("inner")),
+		glide.WithMiddleware(record("outer")),
+	)
+	if _, err := concourse.Info(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(order) != "[outer inner]" {
+		t.Errorf("unexpected middleware order: %v", order)
+	}
+}
+
+func TestWithHTTPClient(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/", func(res http.ResponseWriter, req *http.Request) {
+		<-req.Context().Done()
+	})
+	mux.HandleFunc("/sky/issuer/token", func(res http.ResponseWriter, _ *http.Request) {
+		res.Header().Set("content-type", "application/json")
+		_, _ = io.WriteString(res, `{"access_token":"fake-token","token_type":"bearer","expires_in":3600}`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.NewClient(
+		glide.WithURL(server.URL),
+		glide.WithHTTPClient(&http.Client{Timeout: 20 * time.Millisecond}),
+	)
+
+	if _, err := concourse.Teams(context.Background()); err == nil || !strings.Contains(err.Error(), "Client.Timeout") {
+		t.Errorf("expected the http.Client timeout got: %v", err)
+	}
+	if _, err := concourse.Info(context.Background()); err == nil || !strings.Contains(err.Error(), "Client.Timeout") {
+		t.Errorf("expected the http.Client timeout for unauthenticated requests got: %v", err)
+	}
+}
@@ -0,0 +1,209 @@
+package glide
+
+import (
+	"context"
+	"encoding/json"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Page selects a window of results from a paginated endpoint. Zero values
+// are left for the ATC to default.
+type Page struct {
+	Limit int
+	Since int
+	Until int
+}
+
+// Pagination holds the cursors the ATC returns in the Link header. Next or
+// Previous is nil when there are no more results in that direction.
+type Pagination struct {
+	Next     *Page
+	Previous *Page
+}
+
+// Paginate iterates over every item returned by fetch, following
+// Pagination.Next until there are no more pages. The first page is fetched
+// with the zero Page. Iteration stops after yielding an error from fetch or
+// from ctx.
+//
+//	for build, err := range glide.Paginate(ctx, func(page glide.Page) ([]glide.Build, glide.Pagination, error) {
+//		return client.Builds(ctx, page)
+//	}) {
+//		...
+//	}
+func Paginate[T any](ctx context.Context, fetch func(Page) ([]T, Pagination, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		for page := new(Page); page != nil; {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+			items, pagination, err := fetch(*page)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			page = pagination.Next
+		}
+	}
+}
+
+func (page Page) values() url.Values {
+	values := make(url.Values)
+	if page.Limit > 0 {
+		values.Set("limit", strconv.Itoa(page.Limit))
+	}
+	if page.Since > 0 {
+		values.Set("since", strconv.Itoa(page.Since))
+	}
+	if page.Until > 0 {
+		values.Set("until", strconv.Itoa(page.Until))
+	}
+	return values
+}
+
+func (client *Client) Builds(ctx context.Context, page Page) ([]Build, Pagination, error) {
+	return getPage[Build](ctx, client, page, "builds")
+}
+
+func (client *Client) TeamBuilds(ctx context.Context, team string, page Page) ([]Build, Pagination, error) {
+	return getPage[Build](ctx, client, page, "teams", team, "builds")
+}
+
+func (client *Client) PipelineBuilds(ctx context.Context, team, pipeline string, page Page) ([]Build, Pagination, error) {
+	return getPage[Build](ctx, client, page, "teams", team, "pipelines", pipeline, "builds")
+}
+
+func (client *Client) JobBuildsPage(ctx context.Context, team, pipeline, job string, page Page) ([]Build, Pagination, error) {
+	return getPage[Build](ctx, client, page, "teams", team, "pipelines", pipeline, "jobs", job, "builds")
+}
+
+func (client *Client) ResourceVersionsPage(ctx context.Context, team, pipeline, resource string, page Page) ([]ResourceVersion, Pagination, error) {
+	return getPage[ResourceVersion](ctx, client, page, "teams", team, "pipelines", pipeline, "resources", resource, "versions")
+}
+
+func getPage[T any](ctx context.Context, client *Client, page Page, segments ...string) ([]T, Pagination, error) {
+	u := client.APIPath(segments...)
+	if query := page.values().Encode(); query != "" {
+		u += "?" + query
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+	defer closeAndIgnoreErr(res.Body)
+	if res.StatusCode != http.StatusOK {
+		return nil, Pagination{}, readHTTPError(res)
+	}
+	var result []T
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return nil, Pagination{}, err
+	}
+	return result, parseLink(res.Header), nil
+}
+
+// parseLink reads the RFC 5988 Link header. Links it can not parse are
+// ignored.
+func parseLink(h http.Header) Pagination {
+	var pagination Pagination
+	for _, value := range h.Values("Link") {
+		for _, link := range splitLinks(value) {
+			target, params, found := strings.Cut(link, ";")
+			if !found {
+				continue
+			}
+			target = strings.TrimSpace(target)
+			if len(target) < 2 || target[0] != '<' || target[len(target)-1] != '>' {
+				continue
+			}
+			u, err := url.Parse(target[1 : len(target)-1])
+			if err != nil {
+				continue
+			}
+			page, err := parsePage(u.Query())
+			if err != nil {
+				continue
+			}
+			for _, relation := range linkRelations(params) {
+				switch relation {
+				case "next":
+					pagination.Next = &page
+				case "previous", "prev":
+					pagination.Previous = &page
+				}
+			}
+		}
+	}
+	return pagination
+}
+
+// splitLinks splits a Link header value on the commas between links while
+// ignoring commas inside a link target.
+func splitLinks(value string) []string {
+	var (
+		links    []string
+		start    int
+		inTarget bool
+	)
+	for i, c := range value {
+		switch c {
+		case '<':
+			inTarget = true
+		case '>':
+			inTarget = false
+		case ',':
+			if !inTarget {
+				links = append(links, value[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(links, value[start:])
+}
+
+// linkRelations returns the space separated relation types of the rel
+// parameter.
+func linkRelations(params string) []string {
+	for _, param := range strings.Split(params, ";") {
+		key, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+		if strings.EqualFold(strings.TrimSpace(key), "rel") {
+			return strings.Fields(strings.ToLower(strings.Trim(strings.TrimSpace(value), `"`)))
+		}
+	}
+	return nil
+}
+
+func parsePage(values url.Values) (Page, error) {
+	var (
+		page Page
+		err  error
+	)
+	for key, field := range map[string]*int{
+		"limit": &page.Limit,
+		"since": &page.Since,
+		"until": &page.Until,
+	} {
+		value := values.Get(key)
+		if value == "" {
+			continue
+		}
+		if *field, err = strconv.Atoi(value); err != nil {
+			return Page{}, err
+		}
+	}
+	return page, nil
+}
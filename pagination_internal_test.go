@@ -0,0 +1,92 @@
+package glide
+
+import (
+	"net/http"
+	"testing"
+)
+
+func Test_parseLink(t *testing.T) {
+	for _, tt := range []struct {
+		Name     string
+		Link     []string
+		Next     *Page
+		Previous *Page
+	}{
+		{
+			Name: "no header",
+		},
+		{
+			Name:     "next and previous",
+			Link:     []string{`<http://ci.example.com/api/v1/builds?until=10&limit=5>; rel="next", <http://ci.example.com/api/v1/builds?since=20&limit=5>; rel="previous"`},
+			Next:     &Page{Until: 10, Limit: 5},
+			Previous: &Page{Since: 20, Limit: 5},
+		},
+		{
+			Name:     "separate header values",
+			Link:     []string{`</api/v1/builds?until=10>; rel="next"`, `</api/v1/builds?since=20>; rel="prev"`},
+			Next:     &Page{Until: 10},
+			Previous: &Page{Since: 20},
+		},
+		{
+			Name: "unquoted relation",
+			Link: []string{`</api/v1/builds?until=10>; rel=next`},
+			Next: &Page{Until: 10},
+		},
+		{
+			Name: "multiple relations",
+			Link: []string{`</api/v1/builds?until=10>; rel="next last"`},
+			Next: &Page{Until: 10},
+		},
+		{
+			Name: "comma in target",
+			Link: []string{`</api/v1/builds?until=10&filter=a,b>; rel="next"`},
+			Next: &Page{Until: 10},
+		},
+		{
+			Name: "unknown relation",
+			Link: []string{`</api/v1/builds?until=10>; rel="first"`},
+		},
+		{
+			Name: "missing relation",
+			Link: []string{`</api/v1/builds?until=10>`},
+		},
+		{
+			Name: "missing brackets",
+			Link: []string{`/api/v1/builds?until=10; rel="next"`},
+		},
+		{
+			Name: "non numeric cursor",
+			Link: []string{`</api/v1/builds?until=banana>; rel="next"`},
+		},
+		{
+			Name:     "one malformed link",
+			Link:     []string{`<%zz>; rel="next", </api/v1/builds?since=20>; rel="previous"`},
+			Previous: &Page{Since: 20},
+		},
+		{
+			Name: "garbage",
+			Link: []string{`<`, `>;`, `,,,`, `;rel=next`},
+		},
+	} {
+		t.Run(tt.Name, func(t *testing.T) {
+			h := make(http.Header)
+			for _, value := range tt.Link {
+				h.Add("Link", value)
+			}
+			pagination := parseLink(h)
+			if !equalPage(pagination.Next, tt.Next) {
+				t.Errorf("unexpected next page: got %+v want %+v", pagination.Next, tt.Next)
+			}
+			if !equalPage(pagination.Previous, tt.Previous) {
+				t.Errorf("unexpected previous page: got %+v want %+v", pagination.Previous, tt.Previous)
+			}
+		})
+	}
+}
+
+func equalPage(a, b *Page) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
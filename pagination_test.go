@@ -0,0 +1,72 @@
+package glide_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/crhntr/glide"
+)
+
+func TestClient_Builds(t *testing.T) {
+	concourse := newBuildsClient(t)
+
+	var ids []int
+	page := &glide.Page{Limit: 2}
+	for page != nil {
+		builds, pagination, err := concourse.Builds(context.Background(), *page)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, build := range builds {
+			ids = append(ids, build.ID)
+		}
+		page = pagination.Next
+	}
+	if fmt.Sprint(ids) != "[4 3 2 1]" {
+		t.Errorf("unexpected builds: %v", ids)
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	concourse := newBuildsClient(t)
+
+	ctx := context.Background()
+	var ids []int
+	for build, err := range glide.Paginate(ctx, func(page glide.Page) ([]glide.Build, glide.Pagination, error) {
+		return concourse.Builds(ctx, page)
+	}) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, build.ID)
+	}
+	if fmt.Sprint(ids) != "[4 3 2 1]" {
+		t.Errorf("unexpected builds: %v", ids)
+	}
+}
+
+// newBuildsClient returns a client for a server with four builds paginated
+// two at a time.
+func newBuildsClient(t *testing.T) *glide.Client {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/builds", func(res http.ResponseWriter, req *http.Request) {
+		switch until := req.URL.Query().Get("until"); until {
+		case "":
+			res.Header().Add("Link", fmt.Sprintf(`<%s/api/v1/builds?until=2&limit=2>; rel="next"`, "http://"+req.Host))
+			_, _ = res.Write([]byte(`[{"id":4},{"id":3}]`))
+		case "2":
+			res.Header().Add("Link", fmt.Sprintf(`<%s/api/v1/builds?since=3&limit=2>; rel="previous"`, "http://"+req.Host))
+			_, _ = res.Write([]byte(`[{"id":2},{"id":1}]`))
+		default:
+			t.Errorf("unexpected until: %q", until)
+		}
+	})
+	mux.HandleFunc("/sky/issuer/token", writeToken)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return &glide.Client{URL: server.URL}
+}
@@ -0,0 +1,127 @@
+package glide
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures how Do retries requests that fail with a transient
+// status. The zero value does not retry.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts including the first.
+	MaxAttempts int
+
+	// BaseDelay is doubled after each attempt up to a minute. It defaults to
+	// 100ms. It is also the delay before BuildEvents reopens a failed stream.
+	BaseDelay time.Duration
+
+	// StatusCodes defaults to 429, 502, 503, and 504.
+	StatusCodes []int
+
+	// Methods defaults to GET and HEAD. Methods that are not idempotent
+	// should only be added when the endpoint is known to be safe to repeat.
+	Methods []string
+}
+
+var (
+	defaultRetryStatusCodes = []int{
+		http.StatusTooManyRequests,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout,
+	}
+	defaultRetryMethods = []string{
+		http.MethodGet,
+		http.MethodHead,
+	}
+)
+
+const (
+	defaultRetryBaseDelay = 100 * time.Millisecond
+	maxRetryDelay         = time.Minute
+)
+
+func (policy RetryPolicy) do(req *http.Request, do func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	if policy.MaxAttempts <= 1 || !policy.retriesMethod(req.Method) || (req.Body != nil && req.GetBody == nil) {
+		return do(req)
+	}
+	ctx := req.Context()
+	for attempt := 1; ; attempt++ {
+		attemptReq := req
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(ctx)
+			attemptReq.Body = body
+		}
+		res, err := do(attemptReq)
+		if err != nil || attempt >= policy.MaxAttempts || !policy.retriesStatus(res.StatusCode) {
+			return res, err
+		}
+		delay := policy.delay(attempt, res)
+		closeAndIgnoreErr(res.Body)
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (policy RetryPolicy) retriesMethod(method string) bool {
+	methods := policy.Methods
+	if methods == nil {
+		methods = defaultRetryMethods
+	}
+	return slices.Contains(methods, method)
+}
+
+func (policy RetryPolicy) retriesStatus(code int) bool {
+	codes := policy.StatusCodes
+	if codes == nil {
+		codes = defaultRetryStatusCodes
+	}
+	return slices.Contains(codes, code)
+}
+
+// delay uses the Retry-After header when the ATC sends one with a 429 or 503
+// and otherwise backs off exponentially.
+func (policy RetryPolicy) delay(attempt int, res *http.Response) time.Duration {
+	if res.StatusCode == http.StatusTooManyRequests || res.StatusCode == http.StatusServiceUnavailable {
+		if delay, ok := retryAfter(res.Header.Get("Retry-After")); ok {
+			return delay
+		}
+	}
+	return policy.backoff(attempt)
+}
+
+// backoff doubles BaseDelay for each attempt after the first.
+func (policy RetryPolicy) backoff(attempt int) time.Duration {
+	delay := policy.BaseDelay
+	if delay <= 0 {
+		delay = defaultRetryBaseDelay
+	}
+	for ; attempt > 1 && delay < maxRetryDelay; attempt-- {
+		delay *= 2
+	}
+	return min(delay, maxRetryDelay)
+}
+
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
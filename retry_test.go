@@ -0,0 +1,62 @@
+package glide_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/crhntr/glide"
+)
+
+func TestClient_RetryPolicy(t *testing.T) {
+	var teamRequests atomic.Int64
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/teams", func(res http.ResponseWriter, req *http.Request) {
+		if teamRequests.Add(1) < 3 {
+			res.Header().Set("Retry-After", "0")
+			res.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = io.WriteString(res, `[{"id":1,"name":"main"}]`)
+	})
+	var buildRequests atomic.Int64
+	mux.HandleFunc("/api/v1/teams/main/pipelines/deploy/jobs/test/builds", func(res http.ResponseWriter, req *http.Request) {
+		buildRequests.Add(1)
+		res.WriteHeader(http.StatusBadGateway)
+	})
+	mux.HandleFunc("/sky/issuer/token", writeToken)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	concourse := glide.Client{
+		URL: server.URL,
+		RetryPolicy: glide.RetryPolicy{
+			MaxAttempts: 3,
+			BaseDelay:   time.Millisecond,
+		},
+	}
+
+	t.Run("get", func(t *testing.T) {
+		teams, err := concourse.Teams(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(teams) != 1 {
+			t.Errorf("unexpected teams: %v", teams)
+		}
+		if count := teamRequests.Load(); count != 3 {
+			t.Errorf("expected 3 attempts got %d", count)
+		}
+	})
+	t.Run("post", func(t *testing.T) {
+		if _, err := concourse.CreateJobBuild(context.Background(), "main", "deploy", "test"); err == nil {
+			t.Fatal("expected an error")
+		}
+		if count := buildRequests.Load(); count != 1 {
+			t.Errorf("expected 1 attempt got %d", count)
+		}
+	})
+}
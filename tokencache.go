@@ -0,0 +1,45 @@
+package glide
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+
+	"golang.org/x/oauth2"
+)
+
+// TokenCache stores tokens fetched by a Client. Load returns a nil token
+// when nothing has been stored.
+type TokenCache interface {
+	Load() (*oauth2.Token, error)
+	Store(*oauth2.Token) error
+}
+
+// FileTokenCache stores a token as JSON in the file at Path.
+type FileTokenCache struct {
+	Path string
+}
+
+func (cache FileTokenCache) Load() (*oauth2.Token, error) {
+	buf, err := os.ReadFile(cache.Path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var token oauth2.Token
+	if err := json.Unmarshal(buf, &token); err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+func (cache FileTokenCache) Store(token *oauth2.Token) error {
+	buf, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(cache.Path, buf, 0o600)
+}
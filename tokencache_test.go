@@ -0,0 +1,37 @@
+package glide_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/crhntr/glide"
+)
+
+func TestFileTokenCache(t *testing.T) {
+	var tokenRequests atomic.Int64
+	mux := http.NewServeMux()
+	mux.HandleFunc("/sky/issuer/token", func(res http.ResponseWriter, req *http.Request) {
+		tokenRequests.Add(1)
+		writeToken(res, req)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	cache := glide.FileTokenCache{Path: filepath.Join(t.TempDir(), "token.json")}
+
+	for i := 0; i < 2; i++ {
+		concourse := glide.Client{URL: server.URL, TokenCache: cache}
+		token, err := concourse.Token()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if token.AccessToken != "fake-token" {
+			t.Errorf("unexpected access token: %q", token.AccessToken)
+		}
+	}
+	if count := tokenRequests.Load(); count != 1 {
+		t.Errorf("expected the issuer to be called once got %d", count)
+	}
+}